@@ -2,6 +2,8 @@ package expectations
 
 import (
 	"fmt"
+	"math"
+	"path"
 	"reflect"
 	"runtime"
 	"strings"
@@ -29,6 +31,7 @@ func (defaultLogger) Log(message string) {
 type Et struct {
 	T      FailFunction
 	Logger Logger
+	soft   bool
 }
 
 // NewT creates a struct containing a reference to the testing.T and a default Logger
@@ -47,21 +50,24 @@ type Expectation struct {
 	Logger Logger
 	Value  interface{}
 	failed bool
+	soft   bool
+	// diffContext is the number of unchanged lines shown around changes of multi-line strings, see WithDiffContext
+	diffContext *int
 }
 
 // Expect builds an Expectation which allows to compare the value to expected values
 func (aEt *Et) ExpectThat(value interface{}) *Expectation {
-	return &Expectation{aEt.T, aEt.Logger, value, false}
+	return &Expectation{T: aEt.T, Logger: aEt.Logger, Value: value, soft: aEt.soft}
 }
 
 // Expect builds an Expectation which allows to compare the value to expected values
 func (aEt *Et) ExpectThatString(value string) *StringExpectation {
-	return &StringExpectation{&Expectation{aEt.T, aEt.Logger, value, false}}
+	return &StringExpectation{aEt.ExpectThat(value)}
 }
 
 // ExpectThatSlice builds an Expectation for slices which allows to compare the value to expected values
 func (aEt *Et) ExpectThatSlice(value interface{}) *SliceExpectation {
-	return &SliceExpectation{&Expectation{aEt.T, aEt.Logger, value, false}}
+	return &SliceExpectation{aEt.ExpectThat(value)}
 }
 
 // Reset sets the failed flag to false so that further expectations can be executed
@@ -69,6 +75,26 @@ func (e *Expectation) Reset() {
 	e.failed = false
 }
 
+// skip reports if checks are skipped because a previous check of the chain failed.
+// Soft expectations never skip checks.
+func (e *Expectation) skip() bool {
+	return e.failed && !e.soft
+}
+
+// child builds an Expectation for a value derived from the value of e, e.g. an element of a slice
+func (e *Expectation) child(value interface{}) *Expectation {
+	return &Expectation{T: e.T, Logger: e.Logger, Value: value, soft: e.soft, diffContext: e.diffContext}
+}
+
+// abort returns an Expectation skipping all further checks of the chain.
+// It is used if a derived value is not available, even soft expectations cannot continue without it.
+func (e *Expectation) abort() *Expectation {
+	if !e.soft {
+		return e
+	}
+	return &Expectation{T: e.T, Logger: e.Logger, Value: e.Value, failed: true}
+}
+
 func createMessageOnTypeMismatch(expected, actual interface{}) string {
 	if actual == nil || expected == nil {
 		return ""
@@ -81,32 +107,34 @@ func createMessageOnTypeMismatch(expected, actual interface{}) string {
 	return ""
 }
 
-// Equals fails test if expected is not equal to value
+// Equals fails test if expected is not equal to value.
+// Slices, arrays, maps, structs, pointers and interfaces are compared by their content.
 func (e *Expectation) Equals(expected interface{}) *Expectation {
-	if e.failed {
+	if e.skip() {
 		return e
 	}
 
 	if msg := createMessageOnTypeMismatch(expected, e.Value); msg != "" {
 		e.failed = true
 		fail(e.T, e.Logger, msg)
-	} else if e.Value != expected {
+	} else if !deepEqual(expected, e.Value) {
 		e.failed = true
-		fail(e.T, e.Logger, fmt.Sprintf("Expect %v to equal %v", e.Value, expected))
+		fail(e.T, e.Logger, buildEqualsFailMessage(expected, e.Value))
 	}
 	return e
 }
 
-// DoesNotEqual fails test if expected is equal to value
+// DoesNotEqual fails test if expected is equal to value.
+// Values are compared by their content like in Equals.
 func (e *Expectation) DoesNotEqual(expected interface{}) *Expectation {
-	if e.failed {
+	if e.skip() {
 		return e
 	}
 
 	if msg := createMessageOnTypeMismatch(expected, e.Value); msg != "" {
 		e.failed = true
 		fail(e.T, e.Logger, msg)
-	} else if e.Value == expected {
+	} else if deepEqual(expected, e.Value) {
 		e.failed = true
 		fail(e.T, e.Logger, fmt.Sprintf("Expect %v to not equal %v", e.Value, expected))
 	}
@@ -115,7 +143,7 @@ func (e *Expectation) DoesNotEqual(expected interface{}) *Expectation {
 
 // IsGreater fails test if expected is not greater than value
 func (e *Expectation) IsGreater(referencedValue interface{}) *Expectation {
-	if e.failed {
+	if e.skip() {
 		return e
 	}
 	if msg := createMessageOnTypeMismatch(referencedValue, e.Value); msg != "" {
@@ -130,7 +158,7 @@ func (e *Expectation) IsGreater(referencedValue interface{}) *Expectation {
 
 // IsGreaterOrEqual fails test if expected is not greater than or equal to value
 func (e *Expectation) IsGreaterOrEqual(referencedValue interface{}) *Expectation {
-	if e.failed {
+	if e.skip() {
 		return e
 	}
 
@@ -146,7 +174,7 @@ func (e *Expectation) IsGreaterOrEqual(referencedValue interface{}) *Expectation
 
 // IsLower fails test if expected is not lower than referencedValue
 func (e *Expectation) IsLower(referencedValue interface{}) *Expectation {
-	if e.failed {
+	if e.skip() {
 		return e
 	}
 	if msg := createMessageOnTypeMismatch(referencedValue, e.Value); msg != "" {
@@ -161,7 +189,7 @@ func (e *Expectation) IsLower(referencedValue interface{}) *Expectation {
 
 // IsLowerOrEqual fails test if value is not lower than or equal to referencedValue
 func (e *Expectation) IsLowerOrEqual(referencedValue interface{}) *Expectation {
-	if e.failed {
+	if e.skip() {
 		return e
 	}
 	if msg := createMessageOnTypeMismatch(referencedValue, e.Value); msg != "" {
@@ -187,7 +215,7 @@ func IsNil(value interface{}) bool {
 
 // IsNil fails test if value is not nil
 func (e *Expectation) IsNil() *Expectation {
-	if e.failed {
+	if e.skip() {
 		return e
 	}
 
@@ -200,7 +228,7 @@ func (e *Expectation) IsNil() *Expectation {
 
 // IsNotNil fails test if value is nil
 func (e *Expectation) IsNotNil() *Expectation {
-	if e.failed {
+	if e.skip() {
 		return e
 	}
 	if IsNil((e.Value)) {
@@ -244,13 +272,22 @@ func (e *StringExpectation) IsNotNil() *StringExpectation {
 	return e
 }
 
-// Equals fails test if expected is not equal to value
+// Equals fails test if expected is not equal to value.
+// If one of the strings has multiple lines, a line by line diff is shown on failure.
 func (e *StringExpectation) Equals(expected interface{}) *StringExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 	result := compareEquality(expected, e.E.Value)
-	if result != equal {
+	valueString, valueOk := e.E.Value.(string)
+	expectedString, expectedOk := expected.(string)
+	if result != equal && valueOk && expectedOk && isMultiLine(valueString, expectedString) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildMultiLineFailMessage("Expect multi-line string to equal the expected value:", expectedString, valueString, e.E.diffContextLines()))
+	} else if result != equal && valueOk && expectedOk {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to equal %v\n", result == notComparable, e.E.Value, expected)+buildCharacterDiff(expectedString, valueString, equalRune))
+	} else if result != equal {
 		e.E.failed = true
 		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to equal %v", result == notComparable, e.E.Value, expected))
 	}
@@ -259,7 +296,7 @@ func (e *StringExpectation) Equals(expected interface{}) *StringExpectation {
 
 // EqualsIgnoringCase fails test if expected is not equal to value
 func (e *StringExpectation) EqualsIgnoringCase(expected interface{}) *StringExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 	valueString, valueOk := e.E.Value.(string)
@@ -269,14 +306,14 @@ func (e *StringExpectation) EqualsIgnoringCase(expected interface{}) *StringExpe
 		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to equal ignoring case %v", showTypeInfos, e.E.Value, expected))
 	} else if strings.ToLower(valueString) != strings.ToLower(expectedString) {
 		e.E.failed = true
-		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to equal ignoring case %v", hideTypeInfos, e.E.Value, expected))
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to equal ignoring case %v\n", hideTypeInfos, e.E.Value, expected)+buildCharacterDiff(expectedString, valueString, equalRuneIgnoringCase))
 	}
 	return e
 }
 
 // DoesNotEqual fails test if expected is equal to value
 func (e *StringExpectation) DoesNotEqual(expected interface{}) *StringExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 	if expected == e.E.Value {
@@ -288,7 +325,7 @@ func (e *StringExpectation) DoesNotEqual(expected interface{}) *StringExpectatio
 
 // StartsWith checks if expected starts with value
 func (e *StringExpectation) StartsWith(prefix interface{}) *StringExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 	valueString, valueOk := e.E.Value.(string)
@@ -305,7 +342,7 @@ func (e *StringExpectation) StartsWith(prefix interface{}) *StringExpectation {
 
 // EndsWith checks if expected starts with value
 func (e *StringExpectation) EndsWith(suffix interface{}) *StringExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 	valueString, valueOk := e.E.Value.(string)
@@ -322,7 +359,7 @@ func (e *StringExpectation) EndsWith(suffix interface{}) *StringExpectation {
 
 // Contains checks if expected contains all expected values
 func (e *StringExpectation) Contains(expectedValues ...string) *StringExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 	valueString, valueOk := e.E.Value.(string)
@@ -351,7 +388,7 @@ func (e *StringExpectation) Contains(expectedValues ...string) *StringExpectatio
 
 // DoesNotContain checks if expected does not contain any of the expected values
 func (e *StringExpectation) DoesNotContain(expectedValues ...string) *StringExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 	valueString, valueOk := e.E.Value.(string)
@@ -397,7 +434,7 @@ func (e *SliceExpectation) Reset() {
 
 // Contains checks if expected contains all expected values
 func (e *SliceExpectation) Contains(expectedValues ...interface{}) *SliceExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 	kind := reflect.TypeOf(e.E.Value).Kind()
@@ -425,7 +462,7 @@ func (e *SliceExpectation) Contains(expectedValues ...interface{}) *SliceExpecta
 
 // DoesNotContain checks if expected does not contain any of the expected values
 func (e *SliceExpectation) DoesNotContain(expectedValues ...interface{}) *SliceExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 
@@ -452,7 +489,7 @@ func (e *SliceExpectation) DoesNotContain(expectedValues ...interface{}) *SliceE
 }
 
 func (e *SliceExpectation) IsEmpty(expectedValues ...interface{}) *SliceExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 
@@ -471,7 +508,7 @@ func (e *SliceExpectation) IsEmpty(expectedValues ...interface{}) *SliceExpectat
 }
 
 func (e *SliceExpectation) IsNotEmpty(expectedValues ...interface{}) *SliceExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 
@@ -490,7 +527,7 @@ func (e *SliceExpectation) IsNotEmpty(expectedValues ...interface{}) *SliceExpec
 }
 
 func (e *SliceExpectation) HasSize(expectedValue uint) *SliceExpectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e
 	}
 
@@ -521,22 +558,22 @@ func (e *SliceExpectation) Third() *Expectation {
 }
 
 func (e *SliceExpectation) Nth(nthElement int) *Expectation {
-	if e.E.failed {
+	if e.E.skip() {
 		return e.E
 	}
 
 	if reflect.TypeOf(e.E.Value).Kind() != reflect.Slice {
 		e.E.failed = true
 		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v %T to be a slice", e.E.Value, e.E.Value))
-		return e.E
+		return e.E.abort()
 	}
 	valueAsSlice := toSlice(e.E.Value)
 	if len(valueAsSlice) <= nthElement {
 		e.E.failed = true
 		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v %T to have at least %v elements", e.E.Value, e.E.Value, nthElement+1))
-		return e.E
+		return e.E.abort()
 	}
-	return &Expectation{e.E.T, e.E.Logger, valueAsSlice[nthElement], false}
+	return e.E.child(valueAsSlice[nthElement])
 }
 
 func toSlice(value interface{}) []interface{} {
@@ -616,6 +653,8 @@ func compareUint(expected uint64, actual uint64) uint {
 
 func compareFloat(expected float64, actual float64) uint {
 	switch {
+	case math.IsNaN(expected) || math.IsNaN(actual):
+		return notComparable
 	case actual > expected:
 		return greater
 	case actual < expected:
@@ -677,6 +716,21 @@ func doCompare(expected interface{}, actual interface{}) uint {
 	case string:
 		return compareString(expected.(string), actual.(string))
 	}
+	return compareKind(reflect.ValueOf(expected), reflect.ValueOf(actual))
+}
+
+// compareKind compares types defined as numbers or strings, like celsius defined as float64, by their kind
+func compareKind(expected, actual reflect.Value) uint {
+	switch expected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareInt(expected.Int(), actual.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareUint(expected.Uint(), actual.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareFloat(expected.Float(), actual.Float())
+	case reflect.String:
+		return compareString(expected.String(), actual.String())
+	}
 	return notComparable
 }
 
@@ -722,6 +776,11 @@ var lastFileName string
 func fail(f FailFunction, l Logger, message string) {
 
 	fileName, methodName, line := determineCodeLocation()
+	if collector, ok := l.(*failureCollector); ok {
+		collector.Log(fmt.Sprintf("line %v: %v", line, message))
+		f.Fail()
+		return
+	}
 	if lastFileName != fileName {
 		l.Log(fileName)
 		l.Log(strings.Repeat("-", len(fileName)))
@@ -732,34 +791,38 @@ func fail(f FailFunction, l Logger, message string) {
 }
 
 func determineCodeLocation() (string, string, int) {
-	fileName := getFrame(3).File[strings.LastIndex(getFrame(3).File, "/")+1:]
-	methodName := getFrame(3).Function[strings.LastIndex(getFrame(3).Function, ".")+1:]
-	line := getFrame(3).Line
-	if strings.Contains(methodName, "-fm") && methodName[len(methodName)-3:] == "-fm" {
-		methodName = getFrame(4).Function[strings.LastIndex(getFrame(4).Function, ".")+1:]
-	}
-	return fileName, methodName, line
-}
-
-func getFrame(skipFrames int) runtime.Frame {
-	// We need the frame at index skipFrames+2, since we never want runtime.Callers and getFrame
-	targetFrameIndex := skipFrames + 2
-
-	// Set size to targetFrameIndex+2 to ensure we have room for one more caller than we need
-	programCounters := make([]uintptr, targetFrameIndex+2)
-	n := runtime.Callers(0, programCounters)
-
-	frame := runtime.Frame{Function: "unknown"}
-	if n > 0 {
-		frames := runtime.CallersFrames(programCounters[:n])
-		for more, frameIndex := true, 0; more && frameIndex <= targetFrameIndex; frameIndex++ {
-			var frameCandidate runtime.Frame
-			frameCandidate, more = frames.Next()
-			if frameIndex == targetFrameIndex {
-				frame = frameCandidate
-			}
+	frame := callerFrame()
+	fileName := frame.File[strings.LastIndex(frame.File, "/")+1:]
+	methodName := frame.Function[strings.LastIndex(frame.Function, ".")+1:]
+	return fileName, methodName, frame.Line
+}
+
+// packageDirectory is the directory containing the sources of this package
+var packageDirectory = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return path.Dir(file)
+}()
+
+// callerFrame returns the first frame outside of this package, which is the frame of the test calling the expectation.
+// Expectations may delegate to other expectations or wrap them, so the number of frames to skip is not fixed.
+func callerFrame() runtime.Frame {
+	programCounters := make([]uintptr, 64)
+	n := runtime.Callers(2, programCounters)
+
+	frames := runtime.CallersFrames(programCounters[:n])
+	for more := n > 0; more; {
+		var frame runtime.Frame
+		frame, more = frames.Next()
+		if !isPackageFrame(frame) {
+			return frame
 		}
 	}
+	return runtime.Frame{Function: "unknown"}
+}
 
-	return frame
+func isPackageFrame(frame runtime.Frame) bool {
+	if frame.File == "<autogenerated>" || strings.HasSuffix(frame.Function, "-fm") {
+		return true
+	}
+	return path.Dir(frame.File) == packageDirectory && !strings.HasSuffix(frame.File, "_test.go")
 }
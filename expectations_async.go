@@ -0,0 +1,86 @@
+package expectations
+
+import (
+	"fmt"
+	"time"
+)
+
+// ===================== Asynchronous code ==============================
+
+// Eventually runs probe every interval until it succeeds. The test fails if probe did not succeed within timeout,
+// the failures of the last run are reported.
+// probe is either a func() bool returning true on success or a func(*Et) whose expectations must not fail.
+// Failures of intermediate runs are swallowed.
+func (aEt *Et) Eventually(probe interface{}, timeout, interval time.Duration) {
+	if !isProbe(probe) {
+		fail(aEt.T, aEt.Logger, fmt.Sprintf("Expect probe %T to be a func() bool or a func(*Et)", probe))
+		return
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		result := runProbe(probe)
+		if !result.hasFailures() {
+			return
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			fail(aEt.T, aEt.Logger, fmt.Sprintf("Expect condition to be met within %v, the last failure was:\n%v", timeout, result))
+			return
+		}
+		if remaining < interval {
+			time.Sleep(remaining)
+		} else {
+			time.Sleep(interval)
+		}
+	}
+}
+
+// Consistently runs probe every interval for the given duration. The test fails as soon as probe does not succeed.
+// probe is either a func() bool returning true on success or a func(*Et) whose expectations must not fail.
+func (aEt *Et) Consistently(probe interface{}, duration, interval time.Duration) {
+	if !isProbe(probe) {
+		fail(aEt.T, aEt.Logger, fmt.Sprintf("Expect probe %T to be a func() bool or a func(*Et)", probe))
+		return
+	}
+
+	start := time.Now()
+	for {
+		result := runProbe(probe)
+		if result.hasFailures() {
+			fail(aEt.T, aEt.Logger, fmt.Sprintf("Expect condition to hold for %v but it failed after %v:\n%v", duration, time.Since(start).Round(time.Millisecond), result))
+			return
+		}
+		remaining := duration - time.Since(start)
+		if remaining <= 0 {
+			return
+		}
+		if remaining < interval {
+			time.Sleep(remaining)
+		} else {
+			time.Sleep(interval)
+		}
+	}
+}
+
+func isProbe(probe interface{}) bool {
+	switch probe.(type) {
+	case func() bool, func(*Et):
+		return true
+	}
+	return false
+}
+
+// runProbe executes probe once, failures are buffered instead of being reported
+func runProbe(probe interface{}) *failureCollector {
+	collector := &failureCollector{}
+	switch p := probe.(type) {
+	case func() bool:
+		if !p() {
+			collector.Log("condition returned false")
+		}
+	case func(*Et):
+		p(&Et{T: collector, Logger: collector})
+	}
+	return collector
+}
@@ -0,0 +1,87 @@
+package expectations_test
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/laliluna/expectations"
+)
+
+func TestEventuallySucceeds(t *testing.T) {
+	et := expectations.NewT(t)
+
+	var counter int32
+	go func() {
+		for i := 0; i < 3; i++ {
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&counter, 1)
+		}
+	}()
+
+	et.Eventually(func(e *expectations.Et) {
+		e.ExpectThat(atomic.LoadInt32(&counter)).Equals(int32(3))
+	}, time.Second, time.Millisecond)
+	et.Eventually(func() bool { return atomic.LoadInt32(&counter) == 3 }, time.Second, time.Millisecond)
+}
+
+func TestEventuallyReportsLastFailure(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	attempt := 0
+	et.Eventually(func(e *expectations.Et) {
+		attempt++
+		e.ExpectThat(attempt).Equals(0)
+	}, 20*time.Millisecond, 5*time.Millisecond)
+
+	if !tMock.HasBeenCalled {
+		t.Error("Eventually should fail")
+	}
+	if strings.Count(loggerMock.logs, "to equal") != 1 {
+		t.Errorf("Expected '%v' to only contain the last failure", loggerMock.logs)
+	}
+	if !strings.Contains(loggerMock.logs, "Expect condition to be met within 20ms, the last failure was:") {
+		t.Errorf("Expected '%v' to contain the timeout", loggerMock.logs)
+	}
+}
+
+func TestEventuallyRejectsInvalidProbe(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	et.Eventually(func() {}, time.Millisecond, time.Millisecond)
+	if !tMock.HasBeenCalled {
+		t.Error("Eventually should reject the probe")
+	}
+}
+
+func TestConsistently(t *testing.T) {
+	et := expectations.NewT(t)
+
+	et.Consistently(func() bool { return true }, 10*time.Millisecond, time.Millisecond)
+}
+
+func TestConsistentlyFailsOnFirstFailure(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	attempt := 0
+	et.Consistently(func(e *expectations.Et) {
+		attempt++
+		e.ExpectThat(attempt).IsLower(3)
+	}, time.Second, time.Millisecond)
+
+	if !tMock.HasBeenCalled {
+		t.Error("Consistently should fail")
+	}
+	if attempt != 3 {
+		t.Errorf("Expected Consistently to stop after the failure but probe ran %v times", attempt)
+	}
+	if !strings.Contains(loggerMock.logs, "Expect 3 to be lower than 3") {
+		t.Errorf("Expected '%v' to contain the failure", loggerMock.logs)
+	}
+}
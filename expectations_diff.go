@@ -0,0 +1,266 @@
+package expectations
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxReportedDifferences limits the number of differences printed in a fail message
+const maxReportedDifferences = 50
+
+const (
+	changed = iota
+	added   = iota
+	removed = iota
+)
+
+// difference describes a single deviation found by diff
+type difference struct {
+	path     string
+	kind     int
+	expected reflect.Value
+	actual   reflect.Value
+}
+
+func (d difference) String() string {
+	path := d.path
+	if path == "" {
+		path = "value"
+	}
+	switch d.kind {
+	case added:
+		return fmt.Sprintf("%v: unexpected %v", path, formatValue(d.actual))
+	case removed:
+		return fmt.Sprintf("%v: missing, want %v", path, formatValue(d.expected))
+	}
+	if d.expected.IsValid() && d.actual.IsValid() && d.expected.Type() != d.actual.Type() {
+		return fmt.Sprintf("%v: want %v (%v), got %v (%v)", path, formatValue(d.expected), d.expected.Type(), formatValue(d.actual), d.actual.Type())
+	}
+	return fmt.Sprintf("%v: want %v, got %v", path, formatValue(d.expected), formatValue(d.actual))
+}
+
+func formatValue(value reflect.Value) string {
+	if !value.IsValid() {
+		return "<nil>"
+	}
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		return formatValue(value.Elem())
+	}
+	if value.Kind() == reflect.String {
+		return fmt.Sprintf("%q", value)
+	}
+	if value.Kind() == reflect.Ptr && !value.IsNil() && value.Elem().Kind() != reflect.Ptr {
+		return "&" + formatValue(value.Elem())
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// differ walks two values and collects all differences together with their path
+type differ struct {
+	visited     map[visit]bool
+	differences []difference
+	formatKey   func(key reflect.Value) string
+}
+
+// diff returns the differences between expected and actual. Structs, maps, slices, arrays, pointers and
+// interfaces are walked recursively, all other values are compared like in deepEqual.
+func diff(expected, actual interface{}) []difference {
+	return diffWithPaths("", formatMapKey, expected, actual)
+}
+
+// diffWithPaths works like diff but starts all paths with root and appends map keys using formatKey
+func diffWithPaths(root string, formatKey func(key reflect.Value) string, expected, actual interface{}) []difference {
+	d := &differ{visited: make(map[visit]bool), formatKey: formatKey}
+	d.walk(root, reflect.ValueOf(expected), reflect.ValueOf(actual))
+	return d.differences
+}
+
+func (d *differ) report(path string, kind int, expected, actual reflect.Value) {
+	d.differences = append(d.differences, difference{path, kind, expected, actual})
+}
+
+func (d *differ) walk(path string, expected, actual reflect.Value) {
+	if !expected.IsValid() || !actual.IsValid() || expected.Type() != actual.Type() {
+		if !deepValueEqual(expected, actual, d.visited) {
+			d.report(path, changed, expected, actual)
+		}
+		return
+	}
+
+	switch expected.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				d.report(path, changed, expected, actual)
+			}
+			return
+		}
+		if expected.Kind() == reflect.Ptr {
+			if expected.Pointer() == actual.Pointer() || d.visit(expected, actual) {
+				return
+			}
+		}
+		d.walk(path, expected.Elem(), actual.Elem())
+	case reflect.Struct:
+		if isOpaqueStruct(expected.Type()) {
+			if !deepValueEqual(expected, actual, d.visited) {
+				d.report(path, changed, expected, actual)
+			}
+			return
+		}
+		for i := 0; i < expected.NumField(); i++ {
+			d.walk(path+"."+expected.Type().Field(i).Name, expected.Field(i), actual.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		if expected.Kind() == reflect.Slice && expected.IsNil() != actual.IsNil() {
+			d.report(path, changed, expected, actual)
+			return
+		}
+		if expected.Kind() == reflect.Slice && d.visit(expected, actual) {
+			return
+		}
+		for i := 0; i < expected.Len() || i < actual.Len(); i++ {
+			elementPath := fmt.Sprintf("%v[%v]", path, i)
+			switch {
+			case i >= actual.Len():
+				d.report(elementPath, removed, expected.Index(i), reflect.Value{})
+			case i >= expected.Len():
+				d.report(elementPath, added, reflect.Value{}, actual.Index(i))
+			default:
+				d.walk(elementPath, expected.Index(i), actual.Index(i))
+			}
+		}
+	case reflect.Map:
+		if expected.IsNil() != actual.IsNil() {
+			d.report(path, changed, expected, actual)
+			return
+		}
+		if d.visit(expected, actual) {
+			return
+		}
+		for _, key := range sortedKeys(expected, actual) {
+			keyPath := path + d.formatKey(key)
+			expectedValue := expected.MapIndex(key)
+			actualValue := actual.MapIndex(key)
+			switch {
+			case !actualValue.IsValid():
+				d.report(keyPath, removed, expectedValue, reflect.Value{})
+			case !expectedValue.IsValid():
+				d.report(keyPath, added, reflect.Value{}, actualValue)
+			default:
+				d.walk(keyPath, expectedValue, actualValue)
+			}
+		}
+	default:
+		if !deepValueEqual(expected, actual, d.visited) {
+			d.report(path, changed, expected, actual)
+		}
+	}
+}
+
+// visit records that the pointers, maps or slices are compared and reports if they have been compared before.
+// Walking a cyclic value stops at the second visit like in deepValueEqual.
+func (d *differ) visit(expected, actual reflect.Value) bool {
+	v := newVisit(expected, actual)
+	if d.visited[v] {
+		return true
+	}
+	d.visited[v] = true
+	return false
+}
+
+// isOpaqueStruct reports if the fields of a struct are internals which do not help to find a difference,
+// like the wall clock and location of a time.Time. Such structs are reported as a whole. These are structs
+// without exported fields and structs hiding unexported fields behind a String or Equal method.
+func isOpaqueStruct(structType reflect.Type) bool {
+	if structType == timeType {
+		return true
+	}
+	exported := 0
+	for i := 0; i < structType.NumField(); i++ {
+		if structType.Field(i).IsExported() {
+			exported++
+		}
+	}
+	if exported == 0 {
+		return true
+	}
+	if exported == structType.NumField() {
+		return false
+	}
+	for _, methodSet := range []reflect.Type{structType, reflect.PtrTo(structType)} {
+		_, hasString := methodSet.MethodByName("String")
+		_, hasEqual := methodSet.MethodByName("Equal")
+		if hasString || hasEqual {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of all maps without duplicates, sorted by their printed representation
+func sortedKeys(maps ...reflect.Value) []reflect.Value {
+	var keys []reflect.Value
+	for i, m := range maps {
+		for _, key := range m.MapKeys() {
+			if !containsKey(maps[:i], key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprintf("%v", keys[i]) < fmt.Sprintf("%v", keys[j])
+	})
+	return keys
+}
+
+func containsKey(maps []reflect.Value, key reflect.Value) bool {
+	for _, m := range maps {
+		if m.MapIndex(key).IsValid() {
+			return true
+		}
+	}
+	return false
+}
+
+func formatMapKey(key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return fmt.Sprintf("[%q]", key)
+	}
+	return fmt.Sprintf("[%v]", key)
+}
+
+// isStructured reports if the value is best described by a list of differences instead of printing it
+func isStructured(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Ptr:
+		return true
+	}
+	return false
+}
+
+// formatDifferences renders one line per difference using describe, long lists are truncated
+func formatDifferences(differences []difference, describe func(difference) string) string {
+	var lines []string
+	for i, d := range differences {
+		if i == maxReportedDifferences {
+			lines = append(lines, fmt.Sprintf("\t... and %v more differences", len(differences)-maxReportedDifferences))
+			break
+		}
+		lines = append(lines, "\t"+describe(d))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func buildEqualsFailMessage(expected, actual interface{}) string {
+	if !isStructured(expected) || !isStructured(actual) {
+		return fmt.Sprintf("Expect %v to equal %v", actual, expected)
+	}
+	differences := diff(expected, actual)
+	return fmt.Sprintf("Expect %T to equal the expected value, found %v difference(s):\n%v", actual, len(differences), formatDifferences(differences, difference.String))
+}
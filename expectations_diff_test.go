@@ -0,0 +1,132 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/laliluna/expectations"
+)
+
+type item struct {
+	Price int
+}
+
+type order struct {
+	Items map[string]item
+}
+
+type customer struct {
+	Name   string
+	Orders []order
+}
+
+func TestDiffShowsPathOfDifferences(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	expected := customer{"Joe", []order{order{map[string]item{"sku": item{10}}}, order{map[string]item{"other": item{1}}}}}
+	actual := customer{"Jim", []order{order{map[string]item{"sku": item{12}}}}}
+	et.ExpectThat(actual).Equals(expected)
+
+	if !tMock.HasBeenCalled {
+		t.Error("Expect customers to differ")
+	}
+	for _, expectedLine := range []string{
+		`found 3 difference(s)`,
+		`.Name: want "Joe", got "Jim"`,
+		`.Orders[0].Items["sku"].Price: want 10, got 12`,
+		`.Orders[1]: missing, want {map[other:{1}]}`,
+	} {
+		if !strings.Contains(loggerMock.logs, expectedLine) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedLine)
+		}
+	}
+}
+
+func TestDiffShowsAddedAndRemovedMapEntries(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThat(map[string]int{"a": 1, "c": 3}).Equals(map[string]int{"a": 1, "b": 2})
+
+	for _, expectedLine := range []string{
+		`["b"]: missing, want 2`,
+		`["c"]: unexpected 3`,
+	} {
+		if !strings.Contains(loggerMock.logs, expectedLine) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedLine)
+		}
+	}
+}
+
+func TestDiffShowsTypesOfDifferentNestedValues(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThat([]interface{}{1, "a"}).Equals([]interface{}{uint(1), "a"})
+
+	if !strings.Contains(loggerMock.logs, "[0]: want 1 (uint), got 1 (int)") {
+		t.Errorf("Expected '%v' to show the different types", loggerMock.logs)
+	}
+}
+
+func TestDiffStopsAtCyclicMapsAndSlices(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	expected := map[string]interface{}{"value": 1}
+	expected["self"] = expected
+	actual := map[string]interface{}{"value": 2}
+	actual["self"] = actual
+	et.ExpectThat(actual).Equals(expected)
+	if !strings.Contains(loggerMock.logs, `["value"]: want 1, got 2`) {
+		t.Errorf("Expected '%v' to show the difference of the cyclic maps", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	expectedSlice := []interface{}{1, nil}
+	expectedSlice[1] = expectedSlice
+	actualSlice := []interface{}{2, nil}
+	actualSlice[1] = actualSlice
+	et.ExpectThat(actualSlice).Equals(expectedSlice)
+	if !strings.Contains(loggerMock.logs, `[0]: want 1, got 2`) {
+		t.Errorf("Expected '%v' to show the difference of the cyclic slices", loggerMock.logs)
+	}
+}
+
+func TestDiffShowsAliasedSubslicesOfDifferentLengths(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+	s := []int{1, 2, 3}
+
+	et.ExpectThat(map[string][]int{"a": s[:2], "b": s[:2]}).Equals(map[string][]int{"a": s[:2], "b": s[:3]})
+	if !strings.Contains(loggerMock.logs, `["b"][2]: missing, want 3`) {
+		t.Errorf("Expected '%v' to show the missing element", loggerMock.logs)
+	}
+}
+
+type appointment struct {
+	Title string
+	At    time.Time
+}
+
+func TestDiffShowsTimesAsOneValue(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	et.ExpectThat(appointment{"Dentist", at.Add(time.Hour)}).Equals(appointment{"Dentist", at})
+	if !strings.Contains(loggerMock.logs, "found 1 difference(s):\n\t.At: want 2024-03-01 12:00:00 +0000 UTC, got 2024-03-01 13:00:00 +0000 UTC") {
+		t.Errorf("Expected '%v' to show the times", loggerMock.logs)
+	}
+	if strings.Contains(loggerMock.logs, ".At.") {
+		t.Errorf("Expected '%v' not to show the internals of time.Time", loggerMock.logs)
+	}
+}
@@ -0,0 +1,114 @@
+package expectations
+
+import "reflect"
+
+// visit marks a pair of references which is currently compared, it is used to detect cycles
+type visit struct {
+	expected    uintptr
+	actual      uintptr
+	typ         reflect.Type
+	expectedLen int
+	actualLen   int
+}
+
+// newVisit builds the visit of two pointers, maps or slices. Slices of the same array are only
+// the same if they have the same length, e.g. s[:2] and s[:3] differ.
+func newVisit(expected, actual reflect.Value) visit {
+	v := visit{expected: expected.Pointer(), actual: actual.Pointer(), typ: expected.Type()}
+	if expected.Kind() == reflect.Slice {
+		v.expectedLen, v.actualLen = expected.Len(), actual.Len()
+	}
+	return v
+}
+
+// deepEqual compares expected and actual recursively.
+// Slices, arrays, maps, structs, pointers and interfaces are compared by content. Types must match exactly,
+// also for nested values stored in interfaces.
+func deepEqual(expected, actual interface{}) bool {
+	if expected == nil || actual == nil {
+		return expected == nil && actual == nil
+	}
+	return deepValueEqual(reflect.ValueOf(expected), reflect.ValueOf(actual), make(map[visit]bool))
+}
+
+func deepValueEqual(expected, actual reflect.Value, visited map[visit]bool) bool {
+	if !expected.IsValid() || !actual.IsValid() {
+		return expected.IsValid() == actual.IsValid()
+	}
+	if expected.Type() != actual.Type() {
+		return false
+	}
+
+	switch expected.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		if expected.IsNil() || actual.IsNil() {
+			return expected.IsNil() == actual.IsNil()
+		}
+		if expected.Kind() == reflect.Slice && expected.Len() != actual.Len() {
+			return false
+		}
+		if expected.Kind() != reflect.Slice && expected.Pointer() == actual.Pointer() {
+			return true
+		}
+		v := newVisit(expected, actual)
+		if visited[v] {
+			return true
+		}
+		visited[v] = true
+	}
+
+	switch expected.Kind() {
+	case reflect.Ptr:
+		return deepValueEqual(expected.Elem(), actual.Elem(), visited)
+	case reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			return expected.IsNil() == actual.IsNil()
+		}
+		return deepValueEqual(expected.Elem(), actual.Elem(), visited)
+	case reflect.Slice, reflect.Array:
+		if expected.Len() != actual.Len() {
+			return false
+		}
+		for i := 0; i < expected.Len(); i++ {
+			if !deepValueEqual(expected.Index(i), actual.Index(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if expected.Len() != actual.Len() {
+			return false
+		}
+		for _, key := range expected.MapKeys() {
+			actualValue := actual.MapIndex(key)
+			if !actualValue.IsValid() || !deepValueEqual(expected.MapIndex(key), actualValue, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < expected.NumField(); i++ {
+			if !deepValueEqual(expected.Field(i), actual.Field(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		return expected.IsNil() && actual.IsNil()
+	case reflect.Chan, reflect.UnsafePointer:
+		return expected.Pointer() == actual.Pointer()
+	case reflect.Bool:
+		return expected.Bool() == actual.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return expected.Int() == actual.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return expected.Uint() == actual.Uint()
+	case reflect.Float32, reflect.Float64:
+		return expected.Float() == actual.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return expected.Complex() == actual.Complex()
+	case reflect.String:
+		return expected.String() == actual.String()
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type address struct {
+	Street string
+	City   string
+}
+
+type person struct {
+	Name    string
+	Address *address
+	Tags    []string
+	Scores  map[string]int
+	age     int
+}
+
+type node struct {
+	Value int
+	Next  *node
+}
+
+type DeepEqualityTestCase struct {
+	Value    interface{}
+	Expected interface{}
+	Equal    bool
+}
+
+func TestDeepEquality(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	var nilSlice []int
+	first := person{"Joe", &address{"Main", "Berlin"}, []string{"a"}, map[string]int{"x": 1}, 4}
+
+	testCases := []DeepEqualityTestCase{
+		DeepEqualityTestCase{[]int{1, 2, 3}, []int{1, 2, 3}, true},
+		DeepEqualityTestCase{[]int{1, 2, 3}, []int{1, 2}, false},
+		DeepEqualityTestCase{[]int{1, 2, 3}, []int{1, 3, 2}, false},
+		DeepEqualityTestCase{[]int{}, nilSlice, false},
+		DeepEqualityTestCase{[2]string{"a", "b"}, [2]string{"a", "b"}, true},
+		DeepEqualityTestCase{map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}, true},
+		DeepEqualityTestCase{map[string]int{"a": 1}, map[string]int{"a": 2}, false},
+		DeepEqualityTestCase{map[string]int{"a": 1}, map[string]int{"b": 1}, false},
+		DeepEqualityTestCase{first, person{"Joe", &address{"Main", "Berlin"}, []string{"a"}, map[string]int{"x": 1}, 4}, true},
+		DeepEqualityTestCase{first, person{"Joe", &address{"Main", "Hamburg"}, []string{"a"}, map[string]int{"x": 1}, 4}, false},
+		DeepEqualityTestCase{first, person{"Joe", &address{"Main", "Berlin"}, []string{"a"}, map[string]int{"x": 1}, 5}, false},
+		DeepEqualityTestCase{&address{"Main", "Berlin"}, &address{"Main", "Berlin"}, true},
+		DeepEqualityTestCase{[]interface{}{1, "a"}, []interface{}{1, "a"}, true},
+		DeepEqualityTestCase{[]interface{}{1}, []interface{}{uint(1)}, false},
+		DeepEqualityTestCase{[]interface{}{nil}, []interface{}{nil}, true},
+	}
+
+	for _, testCase := range testCases {
+		tMock.reset()
+		et.ExpectThat(testCase.Value).Equals(testCase.Expected)
+		if testCase.Equal == tMock.HasBeenCalled {
+			t.Errorf("Test failed: %v Equals %v should be %v", testCase.Value, testCase.Expected, testCase.Equal)
+		}
+
+		tMock.reset()
+		et.ExpectThat(testCase.Value).DoesNotEqual(testCase.Expected)
+		if testCase.Equal != tMock.HasBeenCalled {
+			t.Errorf("Test failed: %v DoesNotEqual %v should be %v", testCase.Value, testCase.Expected, !testCase.Equal)
+		}
+	}
+}
+
+func TestDeepEqualityWithCycles(t *testing.T) {
+	first := &node{Value: 1}
+	first.Next = &node{Value: 2, Next: first}
+	second := &node{Value: 1}
+	second.Next = &node{Value: 2, Next: second}
+
+	et := expectations.NewT(t)
+	et.ExpectThat(first).Equals(second)
+
+	tMock := &TMock{}
+	et = expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	second.Next.Value = 3
+	et.ExpectThat(first).Equals(second)
+	if !tMock.HasBeenCalled {
+		t.Error("Expect cyclic structures with different values to differ")
+	}
+}
+
+func TestDeepEqualityComparesAliasedSubslices(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	s := []int{1, 2, 3}
+
+	et.ExpectThat([][]int{s[:2], s[:2]}).Equals([][]int{s[:2], s[:3]})
+	if !tMock.HasBeenCalled {
+		t.Error("Expect subslices of different lengths to differ")
+	}
+	tMock.reset()
+	et.ExpectThat([][]int{s[:2], s[:2]}).DoesNotEqual([][]int{s[:2], s[:3]})
+	if tMock.HasBeenCalled {
+		t.Error("Expect subslices of different lengths not to be equal")
+	}
+}
+
+func TestDeepEqualityRejectsDifferentTypes(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThat([]int{1}).Equals([]int64{1})
+	if !tMock.HasBeenCalled {
+		t.Error("Expect slices of different types to differ")
+	}
+	if !strings.Contains(loggerMock.logs, "You try to compare different types") {
+		t.Errorf("Expected '%v' to indicate different types", loggerMock.logs)
+	}
+}
@@ -0,0 +1,184 @@
+package expectations
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// ===================== Errors ==============================
+
+// ErrorExpectation allows to express expectations on errors and their wrapped errors
+type ErrorExpectation struct {
+	E *Expectation
+}
+
+// ExpectThatError builds an Expectation for errors
+func (aEt *Et) ExpectThatError(err error) *ErrorExpectation {
+	return &ErrorExpectation{aEt.ExpectThat(err)}
+}
+
+// Reset sets the failed flag to false, so that further checks can be executed
+func (e *ErrorExpectation) Reset() {
+	e.E.failed = false
+}
+
+func (e *ErrorExpectation) err() error {
+	err, _ := e.E.Value.(error)
+	return err
+}
+
+func (e *ErrorExpectation) fail(message string) {
+	e.E.failed = true
+	fail(e.E.T, e.E.Logger, message)
+}
+
+// occurred fails the test if there is no error to check
+func (e *ErrorExpectation) occurred(expectation string) bool {
+	if e.err() == nil {
+		e.fail(fmt.Sprintf("Expect an error %v but there was none", expectation))
+		return false
+	}
+	return true
+}
+
+// IsNil fails test if an error occurred
+func (e *ErrorExpectation) IsNil() *ErrorExpectation {
+	if e.E.skip() {
+		return e
+	}
+	if isNilPointer(e.err()) {
+		e.fail(fmt.Sprintf("Expect no error but got a non-nil error interface holding a nil %T", e.err()))
+	} else if e.err() != nil {
+		e.fail(fmt.Sprintf("Expect no error but got:\n%v", formatErrorChain(e.err())))
+	}
+	return e
+}
+
+// Occurred fails test if no error occurred
+func (e *ErrorExpectation) Occurred() *ErrorExpectation {
+	if e.E.skip() {
+		return e
+	}
+	e.occurred("to occur")
+	return e
+}
+
+// Is fails test if the error and its wrapped errors do not match target, see errors.Is
+func (e *ErrorExpectation) Is(target error) *ErrorExpectation {
+	if e.E.skip() || !e.occurred(fmt.Sprintf("matching %v", target)) {
+		return e
+	}
+	if !errors.Is(e.err(), target) {
+		e.fail(fmt.Sprintf("Expect error to match %v (%T) but the error chain was:\n%v", target, target, formatErrorChain(e.err())))
+	}
+	return e
+}
+
+// As fails test if the error and its wrapped errors do not contain an error assignable to target, see errors.As.
+// On success target is set to the found error.
+func (e *ErrorExpectation) As(target interface{}) *ErrorExpectation {
+	if e.E.skip() {
+		return e
+	}
+	targetValue := reflect.ValueOf(target)
+	if target == nil || targetValue.Kind() != reflect.Ptr || targetValue.IsNil() {
+		e.fail(fmt.Sprintf("Expect target %v (%T) of As to be a non-nil pointer", target, target))
+		return e
+	}
+	targetType := targetValue.Type().Elem()
+	if targetType.Kind() != reflect.Interface && !targetType.Implements(reflect.TypeOf((*error)(nil)).Elem()) {
+		e.fail(fmt.Sprintf("Expect target %T of As to point to an interface or to a type implementing error", target))
+		return e
+	}
+	if !e.occurred(fmt.Sprintf("of type %v", targetType)) {
+		return e
+	}
+	if !errors.As(e.err(), target) {
+		e.fail(fmt.Sprintf("Expect error to be of type %v but the error chain was:\n%v", targetType, formatErrorChain(e.err())))
+	}
+	return e
+}
+
+// HasMessage fails test if the message of the error is not equal to message
+func (e *ErrorExpectation) HasMessage(message string) *ErrorExpectation {
+	if e.E.skip() || !e.occurred(fmt.Sprintf("with message %q", message)) {
+		return e
+	}
+	if e.err().Error() != message {
+		e.fail(fmt.Sprintf("Expect error message %q to equal %q, the error chain was:\n%v", e.err().Error(), message, formatErrorChain(e.err())))
+	}
+	return e
+}
+
+// MessageContains fails test if the message of the error does not contain all expected values
+func (e *ErrorExpectation) MessageContains(expectedValues ...string) *ErrorExpectation {
+	if e.E.skip() || !e.occurred(fmt.Sprintf("with message containing %q", expectedValues)) {
+		return e
+	}
+	var lackingValues []string
+	for _, expectedValue := range expectedValues {
+		if !strings.Contains(e.err().Error(), expectedValue) {
+			lackingValues = append(lackingValues, expectedValue)
+		}
+	}
+	if len(lackingValues) > 0 {
+		e.fail(fmt.Sprintf("Expect error message %q to contain %q but was missing %q, the error chain was:\n%v", e.err().Error(), expectedValues, lackingValues, formatErrorChain(e.err())))
+	}
+	return e
+}
+
+// MessageMatches fails test if the message of the error does not match the regular expression pattern
+func (e *ErrorExpectation) MessageMatches(pattern string) *ErrorExpectation {
+	if e.E.skip() {
+		return e
+	}
+	expression, err := regexp.Compile(pattern)
+	if err != nil {
+		e.fail(fmt.Sprintf("Expect %q to be a valid regular expression: %v", pattern, err))
+		return e
+	}
+	if !e.occurred(fmt.Sprintf("with message matching %q", pattern)) {
+		return e
+	}
+	if !expression.MatchString(e.err().Error()) {
+		e.fail(fmt.Sprintf("Expect error message %q to match %q, the error chain was:\n%v", e.err().Error(), pattern, formatErrorChain(e.err())))
+	}
+	return e
+}
+
+// formatErrorChain lists the error and all wrapped errors, one per line. Joined errors are indented.
+func formatErrorChain(err error) string {
+	var lines []string
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		for err != nil {
+			if isNilPointer(err) {
+				lines = append(lines, fmt.Sprintf("\t%vnil %T", strings.Repeat("  ", depth), err))
+				return
+			}
+			lines = append(lines, fmt.Sprintf("\t%v%v (%T)", strings.Repeat("  ", depth), err.Error(), err))
+			switch wrapper := err.(type) {
+			case interface{ Unwrap() error }:
+				err = wrapper.Unwrap()
+			case interface{ Unwrap() []error }:
+				for _, wrapped := range wrapper.Unwrap() {
+					walk(wrapped, depth+1)
+				}
+				return
+			default:
+				return
+			}
+		}
+	}
+	walk(err, 0)
+	return strings.Join(lines, "\n")
+}
+
+// isNilPointer reports if err is a non-nil error interface holding a nil pointer, calling Error on it might panic
+func isNilPointer(err error) bool {
+	value := reflect.ValueOf(err)
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
@@ -0,0 +1,111 @@
+package expectations_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+var errNotFound = errors.New("not found")
+
+type validationError struct {
+	Field string
+}
+
+func (v *validationError) Error() string {
+	return "invalid " + v.Field
+}
+
+type ErrorTestCase struct {
+	Fn       func(*expectations.ErrorExpectation)
+	Err      error
+	Succeeds bool
+}
+
+func TestErrorExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	wrapped := fmt.Errorf("loading user: %w", errNotFound)
+	var validation *validationError
+	var pathError *fs.PathError
+
+	testCases := []ErrorTestCase{
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.IsNil() }, nil, true},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.IsNil() }, wrapped, false},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.Occurred() }, wrapped, true},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.Occurred() }, nil, false},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.Is(errNotFound) }, wrapped, true},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.Is(os.ErrExist) }, wrapped, false},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.Is(errNotFound) }, nil, false},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.As(&validation) }, fmt.Errorf("x: %w", &validationError{"name"}), true},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.As(&pathError) }, wrapped, false},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.As(validation) }, wrapped, false},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.HasMessage("loading user: not found") }, wrapped, true},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.HasMessage("not found") }, wrapped, false},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.MessageContains("user", "not found") }, wrapped, true},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.MessageContains("user", "timeout") }, wrapped, false},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.MessageMatches("^loading \\w+:") }, wrapped, true},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.MessageMatches("^user") }, wrapped, false},
+		ErrorTestCase{func(e *expectations.ErrorExpectation) { e.MessageMatches("(") }, wrapped, false},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(et.ExpectThatError(testCase.Err))
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed: %v should be %v", i, testCase.Err, testCase.Succeeds)
+		}
+	}
+}
+
+func TestErrorAsSetsTarget(t *testing.T) {
+	et := expectations.NewT(t)
+
+	var validation *validationError
+	et.ExpectThatError(fmt.Errorf("x: %w", &validationError{"name"})).As(&validation)
+	et.ExpectThat(validation.Field).Equals("name")
+}
+
+func TestErrorShowsChain(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	err := fmt.Errorf("request failed: %w", errors.Join(errNotFound, &validationError{"id"}))
+	et.ExpectThatError(err).IsNil()
+
+	for _, expectedLine := range []string{
+		"\trequest failed: not found\ninvalid id (*fmt.wrapError)",
+		"\t  not found (*errors.errorString)",
+		"\t  invalid id (*expectations_test.validationError)",
+	} {
+		if !strings.Contains(loggerMock.logs, expectedLine) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedLine)
+		}
+	}
+}
+
+func TestErrorIsNilReportsNilPointer(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	var validation *validationError
+	var err error = validation
+	et.ExpectThatError(err).IsNil()
+	if !strings.Contains(loggerMock.logs, "Expect no error but got a non-nil error interface holding a nil *expectations_test.validationError") {
+		t.Errorf("Expected '%v' to report the nil pointer", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatError(fmt.Errorf("wrapped: %w", err)).Is(errNotFound)
+	if !strings.Contains(loggerMock.logs, "\n\tnil *expectations_test.validationError") {
+		t.Errorf("Expected '%v' to show the nil pointer in the chain", loggerMock.logs)
+	}
+}
@@ -0,0 +1,214 @@
+package expectations
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// ===================== Tolerant comparison of numbers ==============================
+
+// IsCloseTo fails test if value differs from expected by more than delta
+func (e *Expectation) IsCloseTo(expected interface{}, delta float64) *Expectation {
+	if e.skip() || !e.isSameNumberType(expected) {
+		return e
+	}
+	actualFloat, _ := toFloat(e.Value)
+	expectedFloat, _ := toFloat(expected)
+	if difference := math.Abs(actualFloat - expectedFloat); !(difference <= delta) {
+		e.failed = true
+		fail(e.T, e.Logger, fmt.Sprintf("Expect %v to be close to %v within delta %v but the difference is %v", e.Value, expected, delta, difference))
+	}
+	return e
+}
+
+// IsWithinPercent fails test if value differs from expected by more than percent of expected
+func (e *Expectation) IsWithinPercent(expected interface{}, percent float64) *Expectation {
+	if e.skip() || !e.isSameNumberType(expected) {
+		return e
+	}
+	actualFloat, _ := toFloat(e.Value)
+	expectedFloat, _ := toFloat(expected)
+	difference := math.Abs(actualFloat - expectedFloat)
+	if expectedFloat == 0 {
+		if difference != 0 {
+			e.failed = true
+			fail(e.T, e.Logger, fmt.Sprintf("Expect %v to be within %v%% of 0 but only 0 is within a percentage of 0", e.Value, percent))
+		}
+		return e
+	}
+	if !(difference <= math.Abs(expectedFloat)*percent/100) {
+		e.failed = true
+		fail(e.T, e.Logger, fmt.Sprintf("Expect %v to be within %v%% of %v but the difference is %v%%", e.Value, percent, expected, difference/math.Abs(expectedFloat)*100))
+	}
+	return e
+}
+
+// IsWithinULPs fails test if more than ulps floating point numbers are between value and expected.
+// ULP stands for unit in the last place, it adapts the tolerance to the magnitude of the numbers.
+// Only floats are supported, including types defined as float32 or float64.
+func (e *Expectation) IsWithinULPs(expected interface{}, ulps uint64) *Expectation {
+	if e.skip() || !e.isSameNumberType(expected) {
+		return e
+	}
+	distance, ok := ulpDistance(expected, e.Value)
+	if !ok {
+		e.failed = true
+		fail(e.T, e.Logger, fmt.Sprintf("Expect %v (%T) to be a float32 or float64 but not NaN", e.Value, e.Value))
+	} else if distance > ulps {
+		e.failed = true
+		fail(e.T, e.Logger, fmt.Sprintf("Expect %v to be within %v ULPs of %v but the distance is %v ULPs", e.Value, ulps, expected, distance))
+	}
+	return e
+}
+
+// IsNaN fails test if value is not a float which is not a number
+func (e *Expectation) IsNaN() *Expectation {
+	if e.skip() {
+		return e
+	}
+	if value, isFloat := toFloatKind(e.Value); !(isFloat && math.IsNaN(value)) {
+		e.failed = true
+		fail(e.T, e.Logger, buildFailMessage("Expect %v to be NaN", showTypeInfos, e.Value))
+	}
+	return e
+}
+
+// IsInf fails test if value is not a positive or negative infinite float
+func (e *Expectation) IsInf() *Expectation {
+	if e.skip() {
+		return e
+	}
+	if value, isFloat := toFloatKind(e.Value); !(isFloat && math.IsInf(value, 0)) {
+		e.failed = true
+		fail(e.T, e.Logger, buildFailMessage("Expect %v to be infinite", showTypeInfos, e.Value))
+	}
+	return e
+}
+
+// isSameNumberType fails the test if value and expected are not numbers of the same type
+func (e *Expectation) isSameNumberType(expected interface{}) bool {
+	if msg := createMessageOnTypeMismatch(expected, e.Value); msg != "" {
+		e.failed = true
+		fail(e.T, e.Logger, msg)
+		return false
+	}
+	_, valueOk := toFloat(e.Value)
+	_, expectedOk := toFloat(expected)
+	if !(valueOk && expectedOk) {
+		e.failed = true
+		fail(e.T, e.Logger, buildFailMessage("Expect %v and %v to be numbers", showTypeInfos, e.Value, expected))
+		return false
+	}
+	return true
+}
+
+// IsCloseTo fails test if an element of the slice differs from the element of expected by more than delta
+func (e *SliceExpectation) IsCloseTo(expected interface{}, delta float64) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+	if expected == nil || !(reflect.TypeOf(expected).Kind() == reflect.Slice || reflect.TypeOf(expected).Kind() == reflect.Array) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be close to %v within delta %v but %v %T is not a slice", e.E.Value, expected, delta, expected, expected))
+		return e
+	}
+	if msg := createMessageOnTypeMismatch(expected, e.E.Value); msg != "" {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, msg)
+		return e
+	}
+
+	values := toSlice(e.E.Value)
+	expectedValues := toSlice(expected)
+	if len(values) != len(expectedValues) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be close to %v within delta %v but the length is %v instead of %v", e.E.Value, expected, delta, len(values), len(expectedValues)))
+		return e
+	}
+
+	var differences []string
+	for i := range values {
+		value, valueOk := toFloat(values[i])
+		expectedValue, expectedOk := toFloat(expectedValues[i])
+		if !(valueOk && expectedOk) {
+			e.E.failed = true
+			fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v %T to contain numbers", e.E.Value, e.E.Value))
+			return e
+		}
+		if difference := math.Abs(value - expectedValue); !(difference <= delta) {
+			differences = append(differences, fmt.Sprintf("\t[%v]: want %v, got %v, difference %v", i, expectedValues[i], values[i], difference))
+		}
+	}
+	if len(differences) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be close to %v within delta %v but elements differ:\n%v", e.E.Value, expected, delta, strings.Join(differences, "\n")))
+	}
+	return e
+}
+
+// toFloat converts integers, unsigned integers and floats to float64
+func toFloat(value interface{}) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// toFloatKind returns the value of floats including types like celsius defined as float64
+func toFloatKind(value interface{}) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Float32 && v.Kind() != reflect.Float64 {
+		return 0, false
+	}
+	return v.Float(), true
+}
+
+// ulpDistance returns the number of representable floats between expected and actual
+func ulpDistance(expected, actual interface{}) (uint64, bool) {
+	expectedFloat, expectedOk := toFloatKind(expected)
+	actualFloat, actualOk := toFloatKind(actual)
+	if !expectedOk || !actualOk || math.IsNaN(expectedFloat) || math.IsNaN(actualFloat) {
+		return 0, false
+	}
+	var a, b int64
+	if reflect.TypeOf(expected).Kind() == reflect.Float32 {
+		a, b = orderedBits32(float32(expectedFloat)), orderedBits32(float32(actualFloat))
+	} else {
+		a, b = orderedBits64(expectedFloat), orderedBits64(actualFloat)
+	}
+	if a > b {
+		return uint64(a) - uint64(b), true
+	}
+	return uint64(b) - uint64(a), true
+}
+
+// orderedBits64 maps a float to an integer, so that the order of integers matches the order of floats
+func orderedBits64(f float64) int64 {
+	bits := int64(math.Float64bits(f))
+	if bits < 0 {
+		return math.MinInt64 - bits
+	}
+	return bits
+}
+
+func orderedBits32(f float32) int64 {
+	bits := int32(math.Float32bits(f))
+	if bits < 0 {
+		return int64(math.MinInt32 - bits)
+	}
+	return int64(bits)
+}
@@ -0,0 +1,133 @@
+package expectations_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type FloatTestCase struct {
+	Fn       func(*expectations.Expectation)
+	Value    interface{}
+	Succeeds bool
+}
+
+func TestFloatToleranceExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	nextAfterOne := math.Nextafter(1, 2)
+
+	testCases := []FloatTestCase{
+		FloatTestCase{func(e *expectations.Expectation) { e.IsCloseTo(1.1, 0.01) }, 1.105, true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsCloseTo(1.1, 0.01) }, 1.2, false},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsCloseTo(float32(1.1), 0.0001) }, float32(1.1) * 3 / 3, true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsCloseTo(10, 2) }, 11, true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsCloseTo(1.1, 0.01) }, float32(1.1), false},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsCloseTo("a", 0.01) }, "a", false},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsCloseTo(1.0, 0.01) }, math.NaN(), false},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsWithinPercent(100.0, 5) }, 104.0, true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsWithinPercent(100.0, 5) }, 94.0, false},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsWithinPercent(0.0, 5) }, 0.0, true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsWithinPercent(0.0, 5) }, 0.001, false},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsWithinULPs(1.0, 1) }, nextAfterOne, true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsWithinULPs(1.0, 0) }, nextAfterOne, false},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsWithinULPs(0.0, 2) }, math.Copysign(0, -1), true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsWithinULPs(float32(1), 1) }, math.Nextafter32(1, 0), true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsWithinULPs(1, 1) }, 1, false},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsNaN() }, math.NaN(), true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsNaN() }, float32(math.NaN()), true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsNaN() }, 1.0, false},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsInf() }, math.Inf(-1), true},
+		FloatTestCase{func(e *expectations.Expectation) { e.IsInf() }, math.MaxFloat64, false},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(et.ExpectThat(testCase.Value))
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed: %v should be %v", i, testCase.Value, testCase.Succeeds)
+		}
+	}
+}
+
+func TestFloatIsCloseToShowsDifference(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThat(1.5).IsCloseTo(1.0, 0.1)
+	if !strings.Contains(loggerMock.logs, "Expect 1.5 to be close to 1 within delta 0.1 but the difference is 0.5") {
+		t.Errorf("Expected '%v' to show the difference", loggerMock.logs)
+	}
+}
+
+func TestSliceIsCloseTo(t *testing.T) {
+	et := expectations.NewT(t)
+	et.ExpectThatSlice([]float64{1.1, 2.2}).IsCloseTo([]float64{1.1001, 2.1999}, 0.001)
+
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et = expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatSlice([]float64{1.1, 2.2, 3.3}).IsCloseTo([]float64{1.1, 2.5, 3.0}, 0.001)
+	for _, expectedLine := range []string{"[1]: want 2.5, got 2.2", "[2]: want 3, got 3.3"} {
+		if !strings.Contains(loggerMock.logs, expectedLine) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedLine)
+		}
+	}
+
+	tMock.reset()
+	et.ExpectThatSlice([]float64{1.1}).IsCloseTo([]float64{1.1, 2.2}, 0.001)
+	if !tMock.HasBeenCalled {
+		t.Error("Expect slices of different length to fail")
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatSlice([]float64{1.1}).IsCloseTo(nil, 0.001)
+	if !strings.Contains(loggerMock.logs, "Expect [1.1] to be close to <nil> within delta 0.001 but <nil> <nil> is not a slice") {
+		t.Errorf("Expected '%v' to reject the expected value", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatSlice(nil).IsCloseTo([]float64{1.1}, 0.001)
+	if !strings.Contains(loggerMock.logs, "to be a slice") {
+		t.Errorf("Expected '%v' to reject the value", loggerMock.logs)
+	}
+}
+
+func TestFloatIsWithinPercentOfZero(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThat(0.5).IsWithinPercent(0.0, 10)
+	if !strings.Contains(loggerMock.logs, "Expect 0.5 to be within 10% of 0 but only 0 is within a percentage of 0") {
+		t.Errorf("Expected '%v' to explain the exact match", loggerMock.logs)
+	}
+	if strings.Contains(loggerMock.logs, "Inf") {
+		t.Errorf("Expected '%v' not to show an infinite percentage", loggerMock.logs)
+	}
+}
+
+func TestTypedIsCloseTo(t *testing.T) {
+	eT := expectations.NewT(t)
+	expectations.ThatNumber(&eT, celsius(21.5)).IsCloseTo(21.4, 0.2).IsWithinPercent(21, 5)
+	expectations.ThatNumber(&eT, celsius(1)).IsWithinULPs(celsius(math.Nextafter(1, 2)), 1)
+	expectations.ThatNumber(&eT, celsius(math.NaN())).IsNaN()
+	expectations.ThatNumber(&eT, celsius(math.Inf(-1))).IsInf()
+
+	tMock := &TMock{}
+	eT = expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	expectations.ThatNumber(&eT, celsius(1)).IsWithinULPs(celsius(math.Nextafter(math.Nextafter(1, 2), 2)), 1)
+	if !tMock.HasBeenCalled {
+		t.Error("Expect celsius two ULPs apart to fail")
+	}
+	tMock.reset()
+	expectations.ThatNumber(&eT, celsius(1)).IsNaN()
+	if !tMock.HasBeenCalled {
+		t.Error("Expect celsius 1 not to be NaN")
+	}
+}
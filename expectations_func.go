@@ -0,0 +1,136 @@
+package expectations
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+)
+
+// ===================== Functions ==============================
+
+// FuncExpectation allows to express expectations on the panics of a function
+type FuncExpectation struct {
+	E        *Expectation
+	panicked bool
+	stack    string
+}
+
+// ExpectThatFunc executes fn and builds an Expectation for the panic raised by fn.
+// A panic is recovered, the Expectation's value is the recovered value.
+func (aEt *Et) ExpectThatFunc(fn func()) *FuncExpectation {
+	e := &FuncExpectation{E: aEt.ExpectThat(nil)}
+	e.run(fn)
+	return e
+}
+
+func (e *FuncExpectation) run(fn func()) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			e.panicked = true
+			e.E.Value = recovered
+			e.stack = panicStack(debug.Stack())
+		}
+	}()
+	fn()
+}
+
+// panicStack removes the frames of the recovery from a stack trace, the trace starts with the panicking function
+func panicStack(stack []byte) string {
+	trace := string(stack)
+	if start := strings.Index(trace, "\npanic("); start >= 0 {
+		lines := strings.SplitN(trace[start+1:], "\n", 3)
+		if len(lines) == 3 {
+			trace = lines[2]
+		}
+	}
+	return "\t" + strings.Replace(strings.TrimRight(trace, "\n"), "\n", "\n\t", -1)
+}
+
+// Reset sets the failed flag to false, so that further checks can be executed
+func (e *FuncExpectation) Reset() {
+	e.E.failed = false
+}
+
+func (e *FuncExpectation) fail(message string) {
+	e.E.failed = true
+	fail(e.E.T, e.E.Logger, message)
+}
+
+// didPanic fails the test if the function did not panic
+func (e *FuncExpectation) didPanic(expectation string) bool {
+	if !e.panicked {
+		e.fail(fmt.Sprintf("Expect function to panic%v but it returned normally", expectation))
+	}
+	return e.panicked
+}
+
+func (e *FuncExpectation) panicMessage() string {
+	if err, ok := e.E.Value.(error); ok {
+		return err.Error()
+	}
+	return fmt.Sprintf("%v", e.E.Value)
+}
+
+// Panics fails test if the function did not panic
+func (e *FuncExpectation) Panics() *FuncExpectation {
+	if e.E.skip() {
+		return e
+	}
+	e.didPanic("")
+	return e
+}
+
+// PanicsWith fails test if the function did not panic with the expected value
+func (e *FuncExpectation) PanicsWith(expected interface{}) *FuncExpectation {
+	if e.E.skip() || !e.didPanic(fmt.Sprintf(" with %v (%T)", expected, expected)) {
+		return e
+	}
+	if !deepEqual(expected, e.E.Value) {
+		e.fail(fmt.Sprintf("Expect function to panic with %v (%T) but it panicked with %v (%T)\n%v", expected, expected, e.E.Value, e.E.Value, e.stack))
+	}
+	return e
+}
+
+// PanicsWithError fails test if the function did not panic with an error matching target, see errors.Is
+func (e *FuncExpectation) PanicsWithError(target error) *FuncExpectation {
+	if e.E.skip() || !e.didPanic(fmt.Sprintf(" with error %v", target)) {
+		return e
+	}
+	err, isError := e.E.Value.(error)
+	if !isError {
+		e.fail(fmt.Sprintf("Expect function to panic with error %v but it panicked with %v (%T)\n%v", target, e.E.Value, e.E.Value, e.stack))
+	} else if !errors.Is(err, target) {
+		e.fail(fmt.Sprintf("Expect function to panic with error %v but the error chain was:\n%v\n%v", target, formatErrorChain(err), e.stack))
+	}
+	return e
+}
+
+// PanicMessageContains fails test if the function did not panic or if the panic message does not contain all expected values.
+// The message of an error is the result of Error(), other values are printed.
+func (e *FuncExpectation) PanicMessageContains(expectedValues ...string) *FuncExpectation {
+	if e.E.skip() || !e.didPanic(fmt.Sprintf(" with message containing %q", expectedValues)) {
+		return e
+	}
+	var lackingValues []string
+	for _, expectedValue := range expectedValues {
+		if !strings.Contains(e.panicMessage(), expectedValue) {
+			lackingValues = append(lackingValues, expectedValue)
+		}
+	}
+	if len(lackingValues) > 0 {
+		e.fail(fmt.Sprintf("Expect panic message %q to contain %q but was missing %q\n%v", e.panicMessage(), expectedValues, lackingValues, e.stack))
+	}
+	return e
+}
+
+// DoesNotPanic fails test if the function panicked
+func (e *FuncExpectation) DoesNotPanic() *FuncExpectation {
+	if e.E.skip() {
+		return e
+	}
+	if e.panicked {
+		e.fail(fmt.Sprintf("Expect function to not panic but it panicked with %v (%T)\n%v", e.E.Value, e.E.Value, e.stack))
+	}
+	return e
+}
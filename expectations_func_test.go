@@ -0,0 +1,71 @@
+package expectations_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type FuncTestCase struct {
+	Fn       func(*expectations.FuncExpectation)
+	Panic    func()
+	Succeeds bool
+}
+
+func TestFuncExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	returns := func() {}
+	panicsWithString := func() { panic("boom") }
+	panicsWithError := func() { panic(fmt.Errorf("wrapped: %w", errNotFound)) }
+
+	testCases := []FuncTestCase{
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.Panics() }, panicsWithString, true},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.Panics() }, returns, false},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.PanicsWith("boom") }, panicsWithString, true},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.PanicsWith("bang") }, panicsWithString, false},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.PanicsWith("boom") }, returns, false},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.PanicsWithError(errNotFound) }, panicsWithError, true},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.PanicsWithError(errNotFound) }, panicsWithString, false},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.PanicsWithError(errors.New("other")) }, panicsWithError, false},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.PanicMessageContains("wrapped", "not found") }, panicsWithError, true},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.PanicMessageContains("bang") }, panicsWithString, false},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.DoesNotPanic() }, returns, true},
+		FuncTestCase{func(e *expectations.FuncExpectation) { e.DoesNotPanic() }, panicsWithString, false},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(et.ExpectThatFunc(testCase.Panic))
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed, should be %v", i, testCase.Succeeds)
+		}
+	}
+}
+
+func explode() {
+	var values []int
+	_ = values[3]
+}
+
+func TestFuncShowsStackOfPanic(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatFunc(explode).DoesNotPanic()
+
+	if !strings.Contains(loggerMock.logs, "Expect function to not panic but it panicked with runtime error: index out of range") {
+		t.Errorf("Expected '%v' to show the panic", loggerMock.logs)
+	}
+	if !strings.Contains(loggerMock.logs, "expectations_test.explode()") {
+		t.Errorf("Expected '%v' to show the stack of the panic", loggerMock.logs)
+	}
+	if strings.Contains(loggerMock.logs, "runtime/debug.Stack") {
+		t.Errorf("Expected '%v' to hide the frames of the recovery", loggerMock.logs)
+	}
+}
@@ -0,0 +1,183 @@
+package expectations
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ===================== Golden files ==============================
+
+// UpdateGoldenEnv names the environment variable which rewrites golden files and inline snapshots
+const UpdateGoldenEnv = "UPDATE_GOLDEN"
+
+// Update rewrites golden files and inline snapshots with the actual values if true. The library registers
+// no flags, wire it to a flag of your test package if you like:
+//
+//	func init() { flag.BoolVar(&expectations.Update, "update", false, "update golden files") }
+var Update bool
+
+// updateRequested reports if golden files should be rewritten because Update or UPDATE_GOLDEN is set to true
+func updateRequested() bool {
+	if Update {
+		return true
+	}
+	update, err := strconv.ParseBool(os.Getenv(UpdateGoldenEnv))
+	return err == nil && update
+}
+
+// MatchesGolden fails test if value differs from the content of the golden file, a unified diff is shown on failure.
+// If Update or the environment variable UPDATE_GOLDEN is true, the file is written instead.
+func (e *StringExpectation) MatchesGolden(path string) *StringExpectation {
+	if e.E.skip() {
+		return e
+	}
+	valueString, valueOk := e.E.Value.(string)
+	if !valueOk {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to be a string", showTypeInfos, e.E.Value))
+		return e
+	}
+	e.E.matchesGolden(path, valueString)
+	return e
+}
+
+// MatchesGolden fails test if the serialized value differs from the content of the golden file.
+// Values are serialized in Go syntax with one field, element or entry per line. Map entries are
+// sorted by key, so the result is deterministic. See StringExpectation.MatchesGolden for updating the file.
+func (e *Expectation) MatchesGolden(path string) *Expectation {
+	if e.skip() {
+		return e
+	}
+	e.matchesGolden(path, serialize(e.Value)+"\n")
+	return e
+}
+
+func (e *Expectation) matchesGolden(path, actual string) {
+	if updateRequested() {
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		if err == nil {
+			err = os.WriteFile(path, []byte(actual), 0644)
+		}
+		if err != nil {
+			e.failed = true
+			fail(e.T, e.Logger, fmt.Sprintf("Expect golden file %v to be written: %v", path, err))
+		}
+		return
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		e.failed = true
+		fail(e.T, e.Logger, fmt.Sprintf("Expect golden file %v to exist, run the test with UPDATE_GOLDEN=true to create it", path))
+		return
+	} else if err != nil {
+		e.failed = true
+		fail(e.T, e.Logger, fmt.Sprintf("Expect golden file %v to be readable: %v", path, err))
+		return
+	}
+	if expected := string(content); expected != actual {
+		e.failed = true
+		fail(e.T, e.Logger, buildMultiLineFailMessage(fmt.Sprintf("Expect value to match golden file %v, run the test with UPDATE_GOLDEN=true to accept the changes:", path), expected, actual, e.diffContextLines()))
+	}
+}
+
+// serialize renders value in Go syntax, nested values are indented by tabs.
+// Unexported fields are included, map entries are sorted and cycles are marked.
+func serialize(value interface{}) string {
+	var b strings.Builder
+	s := serializer{&b, map[uintptr]bool{}}
+	s.write(reflect.ValueOf(value), 0)
+	return b.String()
+}
+
+type serializer struct {
+	*strings.Builder
+	active map[uintptr]bool
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func (s serializer) write(value reflect.Value, depth int) {
+	if !value.IsValid() {
+		s.WriteString("nil")
+		return
+	}
+	if value.Type() == timeType && value.CanInterface() {
+		s.WriteString(value.Interface().(time.Time).Format(time.RFC3339Nano))
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			s.WriteString("nil")
+			return
+		}
+		if s.active[value.Pointer()] {
+			s.WriteString("<cycle>")
+			return
+		}
+		s.active[value.Pointer()] = true
+		defer delete(s.active, value.Pointer())
+		s.WriteString("&")
+		s.write(value.Elem(), depth)
+	case reflect.Interface:
+		s.write(value.Elem(), depth)
+	case reflect.Struct:
+		s.block(value.Type().String(), value.NumField(), depth, func(i int) {
+			s.WriteString(value.Type().Field(i).Name + ": ")
+			s.write(value.Field(i), depth+1)
+		})
+	case reflect.Map:
+		if value.IsNil() {
+			s.WriteString(value.Type().String() + "(nil)")
+			return
+		}
+		keys := sortedKeys(value)
+		s.block(value.Type().String(), len(keys), depth, func(i int) {
+			s.write(keys[i], depth+1)
+			s.WriteString(": ")
+			s.write(value.MapIndex(keys[i]), depth+1)
+		})
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			s.WriteString(value.Type().String() + "(nil)")
+			return
+		}
+		s.block(value.Type().String(), value.Len(), depth, func(i int) {
+			s.write(value.Index(i), depth+1)
+		})
+	case reflect.String:
+		s.WriteString(strconv.Quote(value.String()))
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if value.IsNil() {
+			s.WriteString(value.Type().String() + "(nil)")
+		} else {
+			s.WriteString(value.Type().String())
+		}
+	default:
+		fmt.Fprintf(s, "%v", value)
+	}
+}
+
+// block writes a composite literal with one line for each of the size entries
+func (s serializer) block(typeName string, size int, depth int, writeEntry func(i int)) {
+	s.WriteString(typeName + "{")
+	if size == 0 {
+		s.WriteString("}")
+		return
+	}
+	for i := 0; i < size; i++ {
+		s.WriteString("\n" + strings.Repeat("\t", depth+1))
+		writeEntry(i)
+		s.WriteString(",")
+	}
+	s.WriteString("\n" + strings.Repeat("\t", depth) + "}")
+}
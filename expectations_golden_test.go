@@ -0,0 +1,107 @@
+package expectations_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+func TestGoldenDemo(t *testing.T) {
+	et := expectations.NewT(t)
+
+	et.ExpectThatString("Hello\nWorld\n").MatchesGolden("testdata/hello.golden")
+	joe := person{"Joe", &address{"Main", "Berlin"}, []string{"a", "b"}, map[string]int{"y": 2, "x": 1}, 4}
+	et.ExpectThat(joe).MatchesGolden("testdata/person.golden")
+}
+
+// withoutUpdate runs the test without update mode, so failing golden files are not rewritten
+func withoutUpdate(t *testing.T) {
+	t.Setenv(expectations.UpdateGoldenEnv, "")
+	update := expectations.Update
+	expectations.Update = false
+	t.Cleanup(func() { expectations.Update = update })
+}
+
+func TestGoldenShowsUnifiedDiff(t *testing.T) {
+	withoutUpdate(t)
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+	directory := t.TempDir()
+	path := filepath.Join(directory, "hello.golden")
+	if err := os.WriteFile(path, []byte("Hello\nWorld\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	et.ExpectThatString("Hello\nGo\n").MatchesGolden(path)
+	if !tMock.HasBeenCalled {
+		t.Error("Expect a different value to fail")
+	}
+	for _, expected := range []string{"Expect value to match golden file " + path, "--- expected", "+++ actual", "- World", "+ Go"} {
+		if !strings.Contains(loggerMock.logs, expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expected)
+		}
+	}
+
+	loggerMock.Reset()
+	missing := filepath.Join(directory, "missing.golden")
+	et.ExpectThatString("Hello").MatchesGolden(missing)
+	if !strings.Contains(loggerMock.logs, "Expect golden file "+missing+" to exist, run the test with UPDATE_GOLDEN=true to create it") {
+		t.Errorf("Expected '%v' to report the missing file", loggerMock.logs)
+	}
+}
+
+func TestGoldenUpdate(t *testing.T) {
+	withoutUpdate(t)
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	path := filepath.Join(t.TempDir(), "nested", "out.golden")
+
+	t.Setenv(expectations.UpdateGoldenEnv, "true")
+	et.ExpectThatString("first").MatchesGolden(path)
+	t.Setenv(expectations.UpdateGoldenEnv, "")
+	et.ExpectThatString("first").MatchesGolden(path)
+	if tMock.HasBeenCalled {
+		t.Error("Expect the golden file to be written with UPDATE_GOLDEN=true")
+	}
+
+	expectations.Update = true
+	et.ExpectThatString("second").MatchesGolden(path)
+	expectations.Update = false
+	content, _ := os.ReadFile(path)
+	if string(content) != "second" {
+		t.Errorf("Expect the golden file to be written with Update but was %q", content)
+	}
+}
+
+func TestGoldenSerializesDeterministically(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+	path := filepath.Join(t.TempDir(), "cycle.golden")
+
+	first := &node{Value: 1}
+	first.Next = &node{Value: 2, Next: first}
+	t.Setenv(expectations.UpdateGoldenEnv, "true")
+	et.ExpectThat(map[string]interface{}{"b": first, "a": []int(nil)}).MatchesGolden(path)
+
+	expected := `map[string]interface {}{
+	"a": []int(nil),
+	"b": &expectations_test.node{
+		Value: 1,
+		Next: &expectations_test.node{
+			Value: 2,
+			Next: <cycle>,
+		},
+	},
+}
+`
+	content, _ := os.ReadFile(path)
+	et.ExpectThatString(string(content)).Equals(expected)
+	if tMock.HasBeenCalled {
+		t.Errorf("Unexpected serialization: %v", loggerMock.logs)
+	}
+}
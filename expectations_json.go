@@ -0,0 +1,370 @@
+package expectations
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ===================== JSON ==============================
+
+// JSONExpectation allows to express expectations on JSON documents
+type JSONExpectation struct {
+	E *Expectation
+}
+
+// ExpectThatJSON builds an Expectation for a JSON document given as string, []byte or json.RawMessage
+func (aEt *Et) ExpectThatJSON(document interface{}) *JSONExpectation {
+	return &JSONExpectation{aEt.ExpectThat(document)}
+}
+
+// Reset sets the failed flag to false, so that further checks can be executed
+func (e *JSONExpectation) Reset() {
+	e.E.failed = false
+}
+
+// document parses the value and fails the test if it is not valid JSON, see parseJSON for exactNumbers
+func (e *JSONExpectation) document(exactNumbers bool) (interface{}, bool) {
+	document, err := parseJSON(e.E.Value, exactNumbers)
+	if err != nil {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be valid JSON: %v", formatDocumentSource(e.E.Value), err))
+		return nil, false
+	}
+	return document, true
+}
+
+// EqualsJSON checks if the document is semantically equal to expected, the order of object keys and whitespace are ignored.
+// Expected may be a JSON document given as string, []byte or json.RawMessage or any other value which is
+// converted with json.Marshal. Numbers are compared exactly, so 1 equals 1.0 but large integers like IDs
+// differ even if they are rounded to the same float64.
+func (e *JSONExpectation) EqualsJSON(expected interface{}) *JSONExpectation {
+	if e.E.skip() {
+		return e
+	}
+	document, ok := e.document(true)
+	if !ok {
+		return e
+	}
+	expectedDocument, err := parseJSON(expected, true)
+	if err != nil {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect the expected value %v to be valid JSON: %v", formatDocumentSource(expected), err))
+		return e
+	}
+
+	if differences := diffWithPaths("$", formatJSONKey, expectedDocument, document); len(differences) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect JSON to equal the expected JSON, found %v difference(s):\n%v", len(differences), formatDifferences(differences, describeJSONDifference)))
+	}
+	return e
+}
+
+// HasPath fails test if the JSON path, for example $.items[0].id, does not exist in the document
+func (e *JSONExpectation) HasPath(path string) *JSONExpectation {
+	if e.E.skip() {
+		return e
+	}
+	e.resolve(path)
+	return e
+}
+
+// Path builds an Expectation for the value found at the JSON path, for example $.items[0].id.
+// Values are decoded like json.Unmarshal does it for an interface{}, so numbers are float64,
+// objects map[string]interface{} and arrays []interface{}.
+func (e *JSONExpectation) Path(path string) *Expectation {
+	if e.E.skip() {
+		return e.E
+	}
+	value, found := e.resolve(path)
+	if !found {
+		return e.E.abort()
+	}
+	return e.E.child(value)
+}
+
+// resolve returns the value found at path and fails the test if the path does not exist
+func (e *JSONExpectation) resolve(path string) (interface{}, bool) {
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to be a valid JSON path: %v", path, err))
+		return nil, false
+	}
+	document, ok := e.document(false)
+	if !ok {
+		return nil, false
+	}
+
+	value, problem := resolveJSONPath(document, steps)
+	if problem != "" {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect JSON to contain path %v but %v", path, problem))
+		return nil, false
+	}
+	return value, true
+}
+
+// jsonPathStep is either an object key or an array index of a JSON path
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (s jsonPathStep) String() string {
+	if s.isIndex {
+		return fmt.Sprintf("[%v]", s.index)
+	}
+	return formatJSONKey(reflect.ValueOf(s.key))
+}
+
+// parseJSONPath supports the root $, .key, ['key'], ["key"] and [index]. Negative indexes count from the end.
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path must start with $")
+	}
+	var steps []jsonPathStep
+	for i := 1; i < len(path); {
+		switch path[i] {
+		case '.':
+			end := i + 1
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			if end == i+1 {
+				return nil, fmt.Errorf("missing key at offset %v", i+1)
+			}
+			steps = append(steps, jsonPathStep{key: path[i+1 : end]})
+			i = end
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if i+1 < len(path) && (path[i+1] == '\'' || path[i+1] == '"') {
+				key, length, err := unquoteJSONPathKey(path[i+1:])
+				if err != nil {
+					return nil, err
+				}
+				end = i + 1 + length
+				if end >= len(path) || path[end] != ']' {
+					return nil, fmt.Errorf("missing ] at offset %v", end)
+				}
+				steps = append(steps, jsonPathStep{key: key})
+				i = end + 1
+				continue
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("missing ] at offset %v", len(path))
+			}
+			index, err := strconv.Atoi(path[i+1 : i+end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %q", path[i+1:i+end])
+			}
+			steps = append(steps, jsonPathStep{index: index, isIndex: true})
+			i += end + 1
+		default:
+			return nil, fmt.Errorf("unexpected %q at offset %v", path[i], i)
+		}
+	}
+	return steps, nil
+}
+
+// unquoteJSONPathKey reads a key in single or double quotes, a backslash escapes the following character.
+// It returns the key and the number of bytes read including the quotes.
+func unquoteJSONPathKey(quoted string) (string, int, error) {
+	var key strings.Builder
+	for i := 1; i < len(quoted); i++ {
+		switch quoted[i] {
+		case quoted[0]:
+			return key.String(), i + 1, nil
+		case '\\':
+			if i++; i == len(quoted) {
+				return "", 0, fmt.Errorf("unterminated key %v", quoted)
+			}
+		}
+		key.WriteByte(quoted[i])
+	}
+	return "", 0, fmt.Errorf("unterminated key %v", quoted)
+}
+
+// resolveJSONPath walks the document along steps. If a step cannot be resolved, the problem is described.
+func resolveJSONPath(document interface{}, steps []jsonPathStep) (interface{}, string) {
+	value := document
+	path := "$"
+	for _, step := range steps {
+		if step.isIndex {
+			array, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Sprintf("%v is a JSON %v and not an array", path, describeJSONType(value))
+			}
+			index := step.index
+			if index < 0 {
+				index += len(array)
+			}
+			if index < 0 || index >= len(array) {
+				return nil, fmt.Sprintf("%v has %v element(s)", path, len(array))
+			}
+			value = array[index]
+		} else {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Sprintf("%v is a JSON %v and not an object", path, describeJSONType(value))
+			}
+			if value, ok = object[step.key]; !ok {
+				return nil, fmt.Sprintf("%v has no key %q", path, step.key)
+			}
+		}
+		path += step.String()
+	}
+	return value, ""
+}
+
+// parseJSON decodes a JSON document given as string, []byte or json.RawMessage.
+// Other values are converted with json.Marshal first. Numbers are float64 unless exactNumbers is set,
+// then they are json.Number with the canonical text of canonicalJSONNumber.
+func parseJSON(source interface{}, exactNumbers bool) (interface{}, error) {
+	var data []byte
+	switch source := source.(type) {
+	case string:
+		data = []byte(source)
+	case []byte:
+		data = source
+	case json.RawMessage:
+		data = source
+	default:
+		var err error
+		if data, err = json.Marshal(source); err != nil {
+			return nil, err
+		}
+	}
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	if exactNumbers {
+		var exactDocument interface{}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&exactDocument); err != nil {
+			return nil, err
+		}
+		return canonicalJSONNumbers(exactDocument), nil
+	}
+	return document, nil
+}
+
+// canonicalJSONNumbers replaces all numbers by the shortest exact decimal of their value,
+// so 1, 1.0 and 1e0 are equal but the digits of large integers are kept
+func canonicalJSONNumbers(value interface{}) interface{} {
+	switch value := value.(type) {
+	case json.Number:
+		return canonicalJSONNumber(value)
+	case []interface{}:
+		for i := range value {
+			value[i] = canonicalJSONNumbers(value[i])
+		}
+	case map[string]interface{}:
+		for key := range value {
+			value[key] = canonicalJSONNumbers(value[key])
+		}
+	}
+	return value
+}
+
+func canonicalJSONNumber(number json.Number) json.Number {
+	rat, ok := new(big.Rat).SetString(string(number))
+	if !ok {
+		return number
+	}
+	if rat.IsInt() {
+		return json.Number(rat.Num().String())
+	}
+	// JSON numbers are decimals, so the fraction ends after a finite number of digits
+	digits := 0
+	ten := big.NewRat(10, 1)
+	for scaled := new(big.Rat).Set(rat); !scaled.IsInt(); digits++ {
+		scaled.Mul(scaled, ten)
+	}
+	return json.Number(rat.FloatString(digits))
+}
+
+// formatDocumentSource quotes documents given as text, other values are printed with their type
+func formatDocumentSource(source interface{}) string {
+	switch source := source.(type) {
+	case string:
+		return strconv.Quote(source)
+	case []byte:
+		return strconv.Quote(string(source))
+	case json.RawMessage:
+		return strconv.Quote(string(source))
+	}
+	return fmt.Sprintf("%v (%T)", source, source)
+}
+
+// formatJSONKey appends simple keys with a dot and all other keys in brackets
+func formatJSONKey(key reflect.Value) string {
+	name := key.String()
+	for i, r := range name {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || i > 0 && r >= '0' && r <= '9') {
+			return "[" + strconv.Quote(name) + "]"
+		}
+	}
+	if name == "" {
+		return `[""]`
+	}
+	return "." + name
+}
+
+func describeJSONDifference(d difference) string {
+	switch d.kind {
+	case added:
+		return fmt.Sprintf("%v: unexpected %v", d.path, formatJSONValue(d.actual))
+	case removed:
+		return fmt.Sprintf("%v: missing, want %v", d.path, formatJSONValue(d.expected))
+	}
+	expectedType := describeJSONType(jsonValue(d.expected))
+	actualType := describeJSONType(jsonValue(d.actual))
+	if expectedType != actualType {
+		return fmt.Sprintf("%v: want %v (%v), got %v (%v)", d.path, formatJSONValue(d.expected), expectedType, formatJSONValue(d.actual), actualType)
+	}
+	return fmt.Sprintf("%v: want %v, got %v", d.path, formatJSONValue(d.expected), formatJSONValue(d.actual))
+}
+
+func jsonValue(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+	return value.Interface()
+}
+
+// formatJSONValue renders a decoded value as compact JSON
+func formatJSONValue(value reflect.Value) string {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(jsonValue(value)); err != nil {
+		return formatValue(value)
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func describeJSONType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64, json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,315 @@
+package expectations
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ConformsToSchema fails test if the document violates the JSON schema given as string, []byte or json.RawMessage.
+// A subset of draft 2020-12 is supported: type, enum, const, properties, required, additionalProperties, items,
+// prefixItems, pattern, minLength, maxLength, minimum, maximum, exclusiveMinimum, exclusiveMaximum, minItems,
+// maxItems and $ref within the schema document. Other keywords are ignored.
+// Every violation is reported together with the path of the offending value.
+func (e *JSONExpectation) ConformsToSchema(schema interface{}) *JSONExpectation {
+	if e.E.skip() {
+		return e
+	}
+	document, ok := e.document(false)
+	if !ok {
+		return e
+	}
+	schemaDocument, err := parseJSON(schema, false)
+	if err != nil {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect the schema %v to be valid JSON: %v", formatDocumentSource(schema), err))
+		return e
+	}
+
+	v := &schemaValidator{root: schemaDocument, activeRefs: make(map[string]bool)}
+	v.validate(schemaDocument, document, "$")
+	if len(v.schemaErrors) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect the schema to be valid, found %v problem(s):\n\t%v", len(v.schemaErrors), strings.Join(v.schemaErrors, "\n\t")))
+	} else if len(v.violations) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect JSON to conform to the schema, found %v violation(s):\n\t%v", len(v.violations), strings.Join(v.violations, "\n\t")))
+	}
+	return e
+}
+
+// schemaValidator collects the violations of a document and the problems of the schema itself
+type schemaValidator struct {
+	root         interface{}
+	violations   []string
+	schemaErrors []string
+	activeRefs   map[string]bool
+}
+
+func (v *schemaValidator) violation(path string, format string, args ...interface{}) {
+	v.violations = append(v.violations, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *schemaValidator) schemaError(keyword string, format string, args ...interface{}) {
+	v.schemaErrors = append(v.schemaErrors, keyword+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *schemaValidator) validate(schema, instance interface{}, path string) {
+	switch schema := schema.(type) {
+	case bool:
+		if !schema {
+			v.violation(path, "%v is not allowed", formatJSON(instance))
+		}
+		return
+	case map[string]interface{}:
+		if ref, ok := schema["$ref"]; ok {
+			v.validateRef(ref, instance, path)
+		}
+		v.validateType(schema, instance, path)
+		v.validateEnum(schema, instance, path)
+		switch instance := instance.(type) {
+		case string:
+			v.validateString(schema, instance, path)
+		case float64:
+			v.validateNumber(schema, instance, path)
+		case []interface{}:
+			v.validateArray(schema, instance, path)
+		case map[string]interface{}:
+			v.validateObject(schema, instance, path)
+		}
+	default:
+		v.schemaError("schema", "expected an object or a boolean but got %v", formatJSON(schema))
+	}
+}
+
+// validateRef applies the schema referenced by a JSON pointer like #/$defs/item
+func (v *schemaValidator) validateRef(ref, instance interface{}, path string) {
+	pointer, ok := ref.(string)
+	if !ok || !strings.HasPrefix(pointer, "#") {
+		v.schemaError("$ref", "only references within the schema document are supported but got %v", formatJSON(ref))
+		return
+	}
+	// a reference which is applied to the same value again would never end
+	key := pointer + " " + path
+	if v.activeRefs[key] {
+		return
+	}
+	v.activeRefs[key] = true
+	defer delete(v.activeRefs, key)
+
+	target, err := resolveJSONPointer(v.root, pointer[1:])
+	if err != nil {
+		v.schemaError("$ref", "cannot resolve %v: %v", pointer, err)
+		return
+	}
+	v.validate(target, instance, path)
+}
+
+// resolveJSONPointer follows pointer, see RFC 6901, starting at document
+func resolveJSONPointer(document interface{}, pointer string) (interface{}, error) {
+	pointer, err := url.PathUnescape(pointer)
+	if err != nil {
+		return nil, err
+	}
+	if pointer == "" {
+		return document, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("pointer must start with /")
+	}
+	unescape := strings.NewReplacer("~1", "/", "~0", "~")
+	value := document
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = unescape.Replace(token)
+		switch container := value.(type) {
+		case map[string]interface{}:
+			next, ok := container[token]
+			if !ok {
+				return nil, fmt.Errorf("no key %q", token)
+			}
+			value = next
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(container) {
+				return nil, fmt.Errorf("no index %q", token)
+			}
+			value = container[index]
+		default:
+			return nil, fmt.Errorf("%v is a JSON %v", token, describeJSONType(value))
+		}
+	}
+	return value, nil
+}
+
+func (v *schemaValidator) validateType(schema map[string]interface{}, instance interface{}, path string) {
+	expected, ok := schema["type"]
+	if !ok {
+		return
+	}
+	var types []string
+	switch expected := expected.(type) {
+	case string:
+		types = []string{expected}
+	case []interface{}:
+		for _, t := range expected {
+			name, ok := t.(string)
+			if !ok {
+				v.schemaError("type", "expected type names but got %v", formatJSON(expected))
+				return
+			}
+			types = append(types, name)
+		}
+	default:
+		v.schemaError("type", "expected a type name but got %v", formatJSON(expected))
+		return
+	}
+
+	for _, t := range types {
+		if hasJSONType(instance, t) {
+			return
+		}
+	}
+	v.violation(path, "%v is a JSON %v but should be %v", formatJSON(instance), describeJSONType(instance), strings.Join(types, " or "))
+}
+
+func hasJSONType(instance interface{}, typeName string) bool {
+	if number, ok := instance.(float64); ok && typeName == "integer" {
+		return number == math.Trunc(number)
+	}
+	return describeJSONType(instance) == typeName
+}
+
+func (v *schemaValidator) validateEnum(schema map[string]interface{}, instance interface{}, path string) {
+	if enum, ok := schema["enum"]; ok {
+		values, ok := enum.([]interface{})
+		if !ok {
+			v.schemaError("enum", "expected an array but got %v", formatJSON(enum))
+		} else if !containsDeepEqual(values, instance) {
+			v.violation(path, "%v is not one of %v", formatJSON(instance), formatJSON(enum))
+		}
+	}
+	if constant, ok := schema["const"]; ok && !deepEqual(constant, instance) {
+		v.violation(path, "%v is not %v", formatJSON(instance), formatJSON(constant))
+	}
+}
+
+func (v *schemaValidator) validateString(schema map[string]interface{}, instance string, path string) {
+	if pattern, ok := schema["pattern"]; ok {
+		expression, isString := pattern.(string)
+		compiled, err := regexp.Compile(expression)
+		if !isString || err != nil {
+			v.schemaError("pattern", "expected a regular expression but got %v", formatJSON(pattern))
+		} else if !compiled.MatchString(instance) {
+			v.violation(path, "%v does not match pattern %v", formatJSON(instance), formatJSON(pattern))
+		}
+	}
+	length := utf8.RuneCountInString(instance)
+	if limit, ok := v.limit(schema, "minLength"); ok && float64(length) < limit {
+		v.violation(path, "%v is shorter than the minimum length %v", formatJSON(instance), limit)
+	}
+	if limit, ok := v.limit(schema, "maxLength"); ok && float64(length) > limit {
+		v.violation(path, "%v is longer than the maximum length %v", formatJSON(instance), limit)
+	}
+}
+
+func (v *schemaValidator) validateNumber(schema map[string]interface{}, instance float64, path string) {
+	if limit, ok := v.limit(schema, "minimum"); ok && instance < limit {
+		v.violation(path, "%v is lower than the minimum %v", instance, limit)
+	}
+	if limit, ok := v.limit(schema, "exclusiveMinimum"); ok && instance <= limit {
+		v.violation(path, "%v is not greater than the exclusive minimum %v", instance, limit)
+	}
+	if limit, ok := v.limit(schema, "maximum"); ok && instance > limit {
+		v.violation(path, "%v is greater than the maximum %v", instance, limit)
+	}
+	if limit, ok := v.limit(schema, "exclusiveMaximum"); ok && instance >= limit {
+		v.violation(path, "%v is not lower than the exclusive maximum %v", instance, limit)
+	}
+}
+
+func (v *schemaValidator) validateArray(schema map[string]interface{}, instance []interface{}, path string) {
+	if limit, ok := v.limit(schema, "minItems"); ok && float64(len(instance)) < limit {
+		v.violation(path, "has %v item(s) but needs at least %v", len(instance), limit)
+	}
+	if limit, ok := v.limit(schema, "maxItems"); ok && float64(len(instance)) > limit {
+		v.violation(path, "has %v item(s) but allows at most %v", len(instance), limit)
+	}
+
+	var prefixItems []interface{}
+	if prefix, ok := schema["prefixItems"]; ok {
+		if prefixItems, ok = prefix.([]interface{}); !ok {
+			v.schemaError("prefixItems", "expected an array but got %v", formatJSON(prefix))
+		}
+	}
+	items, hasItems := schema["items"]
+	for i, element := range instance {
+		elementPath := fmt.Sprintf("%v[%v]", path, i)
+		if i < len(prefixItems) {
+			v.validate(prefixItems[i], element, elementPath)
+		} else if hasItems {
+			v.validate(items, element, elementPath)
+		}
+	}
+}
+
+func (v *schemaValidator) validateObject(schema map[string]interface{}, instance map[string]interface{}, path string) {
+	if required, ok := schema["required"]; ok {
+		names, ok := required.([]interface{})
+		if !ok {
+			v.schemaError("required", "expected an array but got %v", formatJSON(required))
+		}
+		for _, name := range names {
+			if _, found := instance[fmt.Sprint(name)]; !found {
+				v.violation(path, "missing required property %v", formatJSON(name))
+			}
+		}
+	}
+
+	properties := map[string]interface{}{}
+	if value, ok := schema["properties"]; ok {
+		if properties, ok = value.(map[string]interface{}); !ok {
+			v.schemaError("properties", "expected an object but got %v", formatJSON(value))
+		}
+	}
+	additionalProperties, hasAdditionalProperties := schema["additionalProperties"]
+
+	keys := make([]string, 0, len(instance))
+	for key := range instance {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		propertyPath := path + formatJSONKey(reflect.ValueOf(key))
+		if propertySchema, ok := properties[key]; ok {
+			v.validate(propertySchema, instance[key], propertyPath)
+		} else if allowed, ok := additionalProperties.(bool); ok && !allowed {
+			v.violation(propertyPath, "additional property is not allowed")
+		} else if hasAdditionalProperties {
+			v.validate(additionalProperties, instance[key], propertyPath)
+		}
+	}
+}
+
+// limit returns the numeric value of keyword
+func (v *schemaValidator) limit(schema map[string]interface{}, keyword string) (float64, bool) {
+	value, ok := schema[keyword]
+	if !ok {
+		return 0, false
+	}
+	limit, ok := value.(float64)
+	if !ok {
+		v.schemaError(keyword, "expected a number but got %v", formatJSON(value))
+	}
+	return limit, ok
+}
+
+// formatJSON renders a decoded value as compact JSON
+func formatJSON(value interface{}) string {
+	return formatJSONValue(reflect.ValueOf(value))
+}
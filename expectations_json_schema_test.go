@@ -0,0 +1,121 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+const orderSchema = `{
+	"type": "object",
+	"required": ["id", "items"],
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"status": {"enum": ["open", "closed"]},
+		"items": {"type": "array", "minItems": 1, "items": {"$ref": "#/$defs/item"}},
+		"tags": {"type": "array", "prefixItems": [{"const": "first"}], "items": {"type": "string", "maxLength": 3}}
+	},
+	"additionalProperties": false,
+	"$defs": {
+		"item": {
+			"type": "object",
+			"required": ["sku"],
+			"properties": {
+				"sku": {"type": "string", "pattern": "^[A-Z]{2}-[0-9]+$"},
+				"price": {"type": ["number", "null"], "exclusiveMinimum": 0, "maximum": 1000}
+			}
+		}
+	}
+}`
+
+type SchemaTestCase struct {
+	Document string
+	Succeeds bool
+}
+
+func TestJSONConformsToSchema(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	testCases := []SchemaTestCase{
+		SchemaTestCase{`{"id": 1, "items": [{"sku": "AB-1", "price": 9.5}]}`, true},
+		SchemaTestCase{`{"id": 1, "status": "open", "items": [{"sku": "AB-1", "price": null}], "tags": ["first", "abc"]}`, true},
+		SchemaTestCase{`{"id": 1.5, "items": [{"sku": "AB-1"}]}`, false},
+		SchemaTestCase{`{"id": 0, "items": [{"sku": "AB-1"}]}`, false},
+		SchemaTestCase{`{"id": 1, "items": []}`, false},
+		SchemaTestCase{`{"id": 1}`, false},
+		SchemaTestCase{`{"id": 1, "items": [{"sku": "ab-1"}]}`, false},
+		SchemaTestCase{`{"id": 1, "items": [{"sku": "AB-1", "price": 0}]}`, false},
+		SchemaTestCase{`{"id": 1, "items": [{"sku": "AB-1", "price": "1"}]}`, false},
+		SchemaTestCase{`{"id": 1, "items": [{"sku": "AB-1"}], "status": "new"}`, false},
+		SchemaTestCase{`{"id": 1, "items": [{"sku": "AB-1"}], "tags": ["second"]}`, false},
+		SchemaTestCase{`{"id": 1, "items": [{"sku": "AB-1"}], "tags": ["first", "long"]}`, false},
+		SchemaTestCase{`{"id": 1, "items": [{"sku": "AB-1"}], "note": "x"}`, false},
+		SchemaTestCase{`[]`, false},
+	}
+
+	for _, testCase := range testCases {
+		tMock.reset()
+		et.ExpectThatJSON(testCase.Document).ConformsToSchema(orderSchema)
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test failed: %v conforms to the schema should be %v", testCase.Document, testCase.Succeeds)
+		}
+	}
+}
+
+func TestJSONSchemaReportsEveryViolation(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatJSON(`{"id": "7", "items": [{"sku": "AB-1"}, {"price": 2000}], "a note": true}`).ConformsToSchema(orderSchema)
+
+	for _, expected := range []string{
+		"found 4 violation(s)",
+		`$["a note"]: additional property is not allowed`,
+		`$.id: "7" is a JSON string but should be integer`,
+		`$.items[1]: missing required property "sku"`,
+		`$.items[1].price: 2000 is greater than the maximum 1000`,
+	} {
+		if !strings.Contains(loggerMock.logs, expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expected)
+		}
+	}
+}
+
+func TestJSONSchemaSupportsRecursiveReferences(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+	tree := `{"$defs": {"node": {"type": "object", "properties": {"children": {"type": "array", "items": {"$ref": "#/$defs/node"}}, "name": {"type": "string"}}}}, "$ref": "#/$defs/node"}`
+
+	et.ExpectThatJSON(`{"name": "root", "children": [{"name": "a", "children": [{"name": 1}]}]}`).ConformsToSchema(tree)
+	if !strings.Contains(loggerMock.logs, "$.children[0].children[0].name: 1 is a JSON number but should be string") {
+		t.Errorf("Expected '%v' to report the nested violation", loggerMock.logs)
+	}
+
+	tMock.reset()
+	loggerMock.Reset()
+	et.ExpectThatJSON(`{}`).ConformsToSchema(`{"$ref": "#"}`)
+	if tMock.HasBeenCalled {
+		t.Errorf("Expected a reference to itself to terminate, got '%v'", loggerMock.logs)
+	}
+}
+
+func TestJSONSchemaRejectsInvalidSchemas(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatJSON(`{"id": "x"}`).ConformsToSchema(`{"properties": {"id": {"pattern": "(", "$ref": "other.json"}}}`)
+	for _, expected := range []string{
+		"Expect the schema to be valid, found 2 problem(s)",
+		`pattern: expected a regular expression but got "("`,
+		`$ref: only references within the schema document are supported but got "other.json"`,
+	} {
+		if !strings.Contains(loggerMock.logs, expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expected)
+		}
+	}
+}
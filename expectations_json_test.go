@@ -0,0 +1,140 @@
+package expectations_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+const orderJSON = `{
+	"id": 7,
+	"customer": {"name": "Joe", "tags": ["vip"]},
+	"items": [{"id": "a1", "price": 9.5}, {"id": "b2", "price": 3}],
+	"note": null,
+	"a key": true
+}`
+
+type JSONTestCase struct {
+	Fn       func(*expectations.JSONExpectation)
+	Succeeds bool
+}
+
+func TestJSONExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	testCases := []JSONTestCase{
+		JSONTestCase{func(e *expectations.JSONExpectation) {
+			e.EqualsJSON(`{"a key":true,"note":null,"items":[{"price":9.5,"id":"a1"},{"price":3.0,"id":"b2"}],"customer":{"tags":["vip"],"name":"Joe"},"id":7}`)
+		}, true},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.EqualsJSON(`{"id": 7}`) }, false},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.EqualsJSON(`{`) }, false},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.EqualsJSON(`{"id": 7}{}`) }, false},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath("$.items[0].id") }, true},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath("$.items[-1].price") }, true},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath(`$['a key']`) }, true},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath(`$["customer"].tags[0]`) }, true},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath("$.note") }, true},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath("$") }, true},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath("$.items[2]") }, false},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath("$.items.id") }, false},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath("$.customer.age") }, false},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath("items") }, false},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.HasPath("$.items[x]") }, false},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.Path("$.items[1].price").Equals(3.0) }, true},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.Path("$.customer.name").String_().StartsWith("J") }, true},
+		JSONTestCase{func(e *expectations.JSONExpectation) { e.Path("$.id").Equals(8.0) }, false},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(et.ExpectThatJSON(orderJSON))
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed: should be %v", i, testCase.Succeeds)
+		}
+	}
+}
+
+type product struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestJSONDemo(t *testing.T) {
+	et := expectations.NewT(t)
+
+	response := []byte(`{"items": [{"id": 1, "name": "pen"}], "total": 1}`)
+	et.ExpectThatJSON(response).EqualsJSON(`{"total": 1, "items": [{"name": "pen", "id": 1}]}`)
+	et.ExpectThatJSON(response).EqualsJSON(map[string]interface{}{"total": 1, "items": []product{product{1, "pen"}}})
+	et.ExpectThatJSON(json.RawMessage(response)).HasPath("$.items[0].id").Path("$.items[0].name").Equals("pen")
+}
+
+func TestJSONEqualsShowsPathAnnotatedDifferences(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatJSON(`{"id": "7", "items": [{"id": "a1"}], "extra": {"x": 1}, "a key": 2}`).
+		EqualsJSON(`{"id": 7, "items": [{"id": "a2"}, {"id": "b2"}], "a key": 1}`)
+
+	for _, expected := range []string{
+		"found 5 difference(s)",
+		`$["a key"]: want 1, got 2`,
+		`$.extra: unexpected {"x":1}`,
+		`$.id: want 7 (number), got "7" (string)`,
+		`$.items[0].id: want "a2", got "a1"`,
+		`$.items[1]: missing, want {"id":"b2"}`,
+	} {
+		if !strings.Contains(loggerMock.logs, expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expected)
+		}
+	}
+}
+
+func TestJSONEqualsComparesNumbersExactly(t *testing.T) {
+	et := expectations.NewT(t)
+	et.ExpectThatJSON(`[1, 1.50, -0, 2e3, 0.000001, 12345678901234567890]`).
+		EqualsJSON(`[1.0, 1.5, 0, 2000, 1e-6, 12345678901234567890]`)
+
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et = expectations.NewTWithLogger(tMock, &loggerMock)
+	et.ExpectThatJSON(`{"id": 9007199254740993}`).EqualsJSON(`{"id": 9007199254740992}`)
+	if !strings.Contains(loggerMock.logs, "$.id: want 9007199254740992, got 9007199254740993") {
+		t.Errorf("Expected '%v' to show the different IDs", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatJSON(`{"price": 0.1}`).EqualsJSON(map[string]float64{"price": 0.10000000000000002})
+	if !strings.Contains(loggerMock.logs, "$.price: want 0.10000000000000002, got 0.1") {
+		t.Errorf("Expected '%v' to show the different prices", loggerMock.logs)
+	}
+}
+
+func TestJSONPathReportsWhereResolutionStopped(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatJSON(orderJSON).Path("$.items[0].name").Equals("pen")
+	if !strings.Contains(loggerMock.logs, `Expect JSON to contain path $.items[0].name but $.items[0] has no key "name"`) {
+		t.Errorf("Expected '%v' to describe the missing key", loggerMock.logs)
+	}
+	if strings.Count(loggerMock.logs, "Expect") != 1 {
+		t.Errorf("Expected '%v' to stop after the missing path", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatJSON(orderJSON).HasPath("$.customer.tags[3]")
+	if !strings.Contains(loggerMock.logs, "but $.customer.tags has 1 element(s)") {
+		t.Errorf("Expected '%v' to describe the array size", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatJSON(`{"id": 1,}`).HasPath("$.id")
+	if !strings.Contains(loggerMock.logs, `Expect "{\"id\": 1,}" to be valid JSON`) {
+		t.Errorf("Expected '%v' to reject the invalid document", loggerMock.logs)
+	}
+}
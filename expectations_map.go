@@ -0,0 +1,244 @@
+package expectations
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ===================== Maps ==============================
+
+// MapExpectation allows to express expectations on maps
+type MapExpectation struct {
+	E *Expectation
+}
+
+// ExpectThatMap builds an Expectation for maps which allows to check keys, values and entries
+func (aEt *Et) ExpectThatMap(value interface{}) *MapExpectation {
+	return &MapExpectation{aEt.ExpectThat(value)}
+}
+
+// Reset sets the failed flag to false, so that further checks can be executed
+func (e *MapExpectation) Reset() {
+	e.E.failed = false
+}
+
+// isMap fails the test if the value is not a map
+func (e *MapExpectation) isMap() bool {
+	if e.E.Value == nil || reflect.TypeOf(e.E.Value).Kind() != reflect.Map {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v %T to be a map", e.E.Value, e.E.Value))
+		return false
+	}
+	return true
+}
+
+// lookup returns the value stored for key. Keys of a different type are never found.
+func (e *MapExpectation) lookup(key interface{}) (reflect.Value, bool) {
+	m := reflect.ValueOf(e.E.Value)
+	if key == nil || !reflect.TypeOf(key).AssignableTo(m.Type().Key()) || !reflect.TypeOf(key).Comparable() {
+		return reflect.Value{}, false
+	}
+	value := m.MapIndex(reflect.ValueOf(key))
+	return value, value.IsValid()
+}
+
+// keyTypesMatch reports if all keys have the key type of the map
+func (e *MapExpectation) keyTypesMatch(keys []interface{}) bool {
+	keyType := reflect.TypeOf(e.E.Value).Key()
+	for _, key := range keys {
+		if key == nil || !reflect.TypeOf(key).AssignableTo(keyType) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsKey checks if the map contains all expected keys
+func (e *MapExpectation) ContainsKey(expectedKeys ...interface{}) *MapExpectation {
+	if e.E.skip() || !e.isMap() {
+		return e
+	}
+
+	var lackingKeys []interface{}
+	for _, key := range expectedKeys {
+		if _, found := e.lookup(key); !found {
+			lackingKeys = append(lackingKeys, key)
+		}
+	}
+
+	if len(lackingKeys) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain keys %v but was missing %v", !e.keyTypesMatch(expectedKeys), e.E.Value, expectedKeys, lackingKeys))
+	}
+	return e
+}
+
+// DoesNotContainKey checks if the map does not contain any of the keys
+func (e *MapExpectation) DoesNotContainKey(keys ...interface{}) *MapExpectation {
+	if e.E.skip() || !e.isMap() {
+		return e
+	}
+
+	var foundKeys []interface{}
+	for _, key := range keys {
+		if _, found := e.lookup(key); found {
+			foundKeys = append(foundKeys, key)
+		}
+	}
+
+	if len(foundKeys) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to not contain keys %v but it includes %v", false, e.E.Value, keys, foundKeys))
+	}
+	return e
+}
+
+// ContainsEntry checks if the map contains the key and if the value stored for the key equals expectedValue
+func (e *MapExpectation) ContainsEntry(key, expectedValue interface{}) *MapExpectation {
+	if e.E.skip() || !e.isMap() {
+		return e
+	}
+
+	value, found := e.lookup(key)
+	if !found {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain entry %v: %v but key %v is missing", !e.keyTypesMatch([]interface{}{key}), e.E.Value, key, expectedValue, key))
+	} else if !deepEqual(expectedValue, value.Interface()) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain entry %v: %v but value was %v", createMessageOnTypeMismatch(expectedValue, value.Interface()) != "", e.E.Value, key, expectedValue, value.Interface()))
+	}
+	return e
+}
+
+// ContainsValue checks if the map contains all expected values
+func (e *MapExpectation) ContainsValue(expectedValues ...interface{}) *MapExpectation {
+	if e.E.skip() || !e.isMap() {
+		return e
+	}
+
+	values := mapValues(e.E.Value)
+	var lackingValues []interface{}
+	for _, expectedValue := range expectedValues {
+		if !containsDeepEqual(values, expectedValue) {
+			lackingValues = append(lackingValues, expectedValue)
+		}
+	}
+
+	if len(lackingValues) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain values %v but was missing %v", !checkTypesMatch(values, expectedValues), e.E.Value, expectedValues, lackingValues))
+	}
+	return e
+}
+
+// ContainsOnlyKeys checks if the map contains all expected keys and no other keys
+func (e *MapExpectation) ContainsOnlyKeys(expectedKeys ...interface{}) *MapExpectation {
+	if e.E.skip() || !e.isMap() {
+		return e
+	}
+
+	var lackingKeys []interface{}
+	for _, key := range expectedKeys {
+		if _, found := e.lookup(key); !found {
+			lackingKeys = append(lackingKeys, key)
+		}
+	}
+	var unexpectedKeys []interface{}
+	for _, key := range sortedKeys(reflect.ValueOf(e.E.Value)) {
+		if !containsDeepEqual(expectedKeys, key.Interface()) {
+			unexpectedKeys = append(unexpectedKeys, key.Interface())
+		}
+	}
+
+	if len(lackingKeys) > 0 || len(unexpectedKeys) > 0 {
+		var problems []string
+		args := []interface{}{e.E.Value, expectedKeys}
+		if len(lackingKeys) > 0 {
+			problems = append(problems, "was missing %v")
+			args = append(args, lackingKeys)
+		}
+		if len(unexpectedKeys) > 0 {
+			problems = append(problems, "has unexpected %v")
+			args = append(args, unexpectedKeys)
+		}
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain only keys %v but it "+strings.Join(problems, " and "), !e.keyTypesMatch(expectedKeys), args...))
+	}
+	return e
+}
+
+// IsEmpty fails test if the map has entries
+func (e *MapExpectation) IsEmpty() *MapExpectation {
+	if e.E.skip() || !e.isMap() {
+		return e
+	}
+
+	if reflect.ValueOf(e.E.Value).Len() > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v %T to be empty", e.E.Value, e.E.Value))
+	}
+	return e
+}
+
+// IsNotEmpty fails test if the map has no entries
+func (e *MapExpectation) IsNotEmpty() *MapExpectation {
+	if e.E.skip() || !e.isMap() {
+		return e
+	}
+
+	if reflect.ValueOf(e.E.Value).Len() == 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v %T to be not empty", e.E.Value, e.E.Value))
+	}
+	return e
+}
+
+// HasSize fails test if the map has not the expected number of entries
+func (e *MapExpectation) HasSize(expectedValue uint) *MapExpectation {
+	if e.E.skip() || !e.isMap() {
+		return e
+	}
+
+	if size := reflect.ValueOf(e.E.Value).Len(); size != int(expectedValue) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect len of %v %T to be %v and not %v", e.E.Value, e.E.Value, expectedValue, size))
+	}
+	return e
+}
+
+// Get builds an Expectation for the value stored for key
+func (e *MapExpectation) Get(key interface{}) *Expectation {
+	if e.E.skip() {
+		return e.E
+	}
+	if !e.isMap() {
+		return e.E.abort()
+	}
+
+	value, found := e.lookup(key)
+	if !found {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain key %v", !e.keyTypesMatch([]interface{}{key}), e.E.Value, key))
+		return e.E.abort()
+	}
+	return e.E.child(value.Interface())
+}
+
+func mapValues(value interface{}) []interface{} {
+	m := reflect.ValueOf(value)
+	var result []interface{}
+	for _, key := range sortedKeys(m) {
+		result = append(result, m.MapIndex(key).Interface())
+	}
+	return result
+}
+
+func containsDeepEqual(values []interface{}, expectedValue interface{}) bool {
+	for _, value := range values {
+		if deepEqual(expectedValue, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type MapTestCase struct {
+	Fn            func(...interface{}) *expectations.MapExpectation
+	ExpectedValue []interface{}
+	Succeeds      bool
+}
+
+func TestMapExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	actualValue := map[string]int{"a": 1, "b": 2}
+	expect := et.ExpectThatMap(actualValue)
+
+	testCases := []MapTestCase{
+		MapTestCase{expect.ContainsKey, []interface{}{"a", "b"}, true},
+		MapTestCase{expect.ContainsKey, []interface{}{"a", "c"}, false},
+		MapTestCase{expect.ContainsKey, []interface{}{1}, false},
+		MapTestCase{expect.DoesNotContainKey, []interface{}{"c", "d"}, true},
+		MapTestCase{expect.DoesNotContainKey, []interface{}{"c", "a"}, false},
+		MapTestCase{expect.ContainsValue, []interface{}{1, 2}, true},
+		MapTestCase{expect.ContainsValue, []interface{}{3}, false},
+		MapTestCase{expect.ContainsValue, []interface{}{uint(1)}, false},
+		MapTestCase{expect.ContainsOnlyKeys, []interface{}{"b", "a"}, true},
+		MapTestCase{expect.ContainsOnlyKeys, []interface{}{"a"}, false},
+		MapTestCase{expect.ContainsOnlyKeys, []interface{}{"a", "b", "c"}, false},
+	}
+
+	for _, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(testCase.ExpectedValue...)
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test failed: %v %v %v should be %v", actualValue, functionName(testCase.Fn), testCase.ExpectedValue, testCase.Succeeds)
+		}
+		expect.Reset()
+	}
+}
+
+func TestMapDemo(t *testing.T) {
+	et := expectations.NewT(t)
+
+	scores := map[string][]int{"joe": []int{1, 2}, "jim": nil}
+	et.ExpectThatMap(scores).HasSize(2).IsNotEmpty().ContainsEntry("joe", []int{1, 2})
+	et.ExpectThatMap(scores).Get("joe").Equals([]int{1, 2})
+	et.ExpectThatMap(map[int]string{}).IsEmpty()
+}
+
+func TestMapContainsEntryFails(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatMap(map[string]int{"a": 1}).ContainsEntry("a", 2)
+	if !strings.Contains(loggerMock.logs, "Expect map[a:1] to contain entry a: 2 but value was 1") {
+		t.Errorf("Expected '%v' to show the different value", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatMap(map[string]int{"a": 1}).ContainsEntry("b", 1)
+	if !strings.Contains(loggerMock.logs, "Expect map[a:1] to contain entry b: 1 but key b is missing") {
+		t.Errorf("Expected '%v' to show the missing key", loggerMock.logs)
+	}
+}
+
+func TestMapContainsOnlyKeysReportsMissingAndUnexpectedKeys(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatMap(map[string]int{"a": 1, "c": 3}).ContainsOnlyKeys("a", "b")
+	if !strings.Contains(loggerMock.logs, "Expect map[a:1 c:3] to contain only keys [a b] but it was missing [b] and has unexpected [c]") {
+		t.Errorf("Expected '%v' to show missing and unexpected keys", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatMap(map[string]int{"100%": 1}).ContainsOnlyKeys("50%")
+	if !strings.Contains(loggerMock.logs, "Expect map[100%:1] to contain only keys [50%] but it was missing [50%] and has unexpected [100%]") {
+		t.Errorf("Expected '%v' to show keys with percent signs", loggerMock.logs)
+	}
+}
+
+func TestMapWithInterfaceKeysRejectsUncomparableKeys(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	et.ExpectThatMap(map[interface{}]int{"a": 1}).ContainsKey([]int{1})
+	if !tMock.HasBeenCalled {
+		t.Error("Expect a slice not to be found as key")
+	}
+	tMock.reset()
+	et.ExpectThatMap(map[interface{}]int{"a": 1}).DoesNotContainKey([]int{1}).ContainsKey("a")
+	if tMock.HasBeenCalled {
+		t.Error("Expect a slice not to be contained as key")
+	}
+}
+
+func TestMapGetStopsOnMissingKey(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatMap(map[string]int{"a": 1}).Get("b").Equals(1)
+	if !strings.Contains(loggerMock.logs, "Expect map[a:1] to contain key b") {
+		t.Errorf("Expected '%v' to show the missing key", loggerMock.logs)
+	}
+	if strings.Contains(loggerMock.logs, "to equal") {
+		t.Errorf("Expected '%v' to stop after the missing key", loggerMock.logs)
+	}
+}
+
+func TestMapRejectsOtherTypes(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	et.ExpectThatMap([]int{1}).ContainsKey(0)
+	if !tMock.HasBeenCalled {
+		t.Error("Expect a slice to be rejected")
+	}
+}
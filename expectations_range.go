@@ -0,0 +1,134 @@
+package expectations
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ===================== Ranges ==============================
+
+// IsBetween fails test if value is lower than lowerBound or greater than upperBound
+func (e *Expectation) IsBetween(lowerBound, upperBound interface{}) *Expectation {
+	return e.checkRange(lowerBound, upperBound, true)
+}
+
+// IsStrictlyBetween fails test if value is not greater than lowerBound and lower than upperBound
+func (e *Expectation) IsStrictlyBetween(lowerBound, upperBound interface{}) *Expectation {
+	return e.checkRange(lowerBound, upperBound, false)
+}
+
+func (e *Expectation) checkRange(lowerBound, upperBound interface{}, inclusive bool) *Expectation {
+	if e.skip() {
+		return e
+	}
+	expectation := rangeExpectation(inclusive)
+
+	for _, bound := range []interface{}{lowerBound, upperBound} {
+		if msg := createMessageOnTypeMismatch(bound, e.Value); msg != "" {
+			e.failed = true
+			fail(e.T, e.Logger, msg)
+			return e
+		}
+	}
+	if hasNaN(e.Value, lowerBound, upperBound) {
+		e.failed = true
+		fail(e.T, e.Logger, fmt.Sprintf("Expect %v to be %v %v and %v but NaN is not comparable", e.Value, expectation, lowerBound, upperBound))
+		return e
+	}
+	lowerResult := doCompare(lowerBound, e.Value)
+	upperResult := doCompare(upperBound, e.Value)
+	if lowerResult == notComparable || upperResult == notComparable {
+		e.failed = true
+		fail(e.T, e.Logger, buildFailMessage("Expect %v to be "+expectation+" %v and %v", showTypeInfos, e.Value, lowerBound, upperBound))
+		return e
+	}
+
+	if violation := rangeViolation(lowerBound, upperBound, lowerResult, upperResult, inclusive); violation != "" {
+		e.failed = true
+		fail(e.T, e.Logger, fmt.Sprintf("Expect %v to be %v %v and %v but %v", e.Value, expectation, lowerBound, upperBound, violation))
+	}
+	return e
+}
+
+// hasNaN reports if one of the values is a float which is not a number, NaN is neither lower, equal nor greater
+func hasNaN(values ...interface{}) bool {
+	for _, value := range values {
+		if f, isNumber := toFloat(value); isNumber && math.IsNaN(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeViolation describes which bound is violated, lowerResult and upperResult are the results of comparing the value to the bounds
+func rangeViolation(lowerBound, upperBound interface{}, lowerResult, upperResult uint, inclusive bool) string {
+	switch {
+	case lowerResult == lower:
+		return fmt.Sprintf("it is lower than the lower bound %v", lowerBound)
+	case lowerResult == equal && !inclusive:
+		return fmt.Sprintf("it is equal to the lower bound %v", lowerBound)
+	case upperResult == greater:
+		return fmt.Sprintf("it is greater than the upper bound %v", upperBound)
+	case upperResult == equal && !inclusive:
+		return fmt.Sprintf("it is equal to the upper bound %v", upperBound)
+	}
+	return ""
+}
+
+func rangeExpectation(inclusive bool) string {
+	if inclusive {
+		return "between"
+	}
+	return "strictly between"
+}
+
+// IsPositive fails test if value is not greater than zero
+func (e *Expectation) IsPositive() *Expectation {
+	return e.checkSign("positive", greater)
+}
+
+// IsNegative fails test if value is not lower than zero
+func (e *Expectation) IsNegative() *Expectation {
+	return e.checkSign("negative", lower)
+}
+
+// IsZero fails test if value is not zero
+func (e *Expectation) IsZero() *Expectation {
+	return e.checkSign("zero", equal)
+}
+
+// checkSign compares value to the zero value of its type
+func (e *Expectation) checkSign(expectation string, expectedResult uint) *Expectation {
+	if e.skip() {
+		return e
+	}
+	if _, isNumber := toFloat(e.Value); !isNumber {
+		e.failed = true
+		fail(e.T, e.Logger, buildFailMessage("Expect %v to be a number", showTypeInfos, e.Value))
+		return e
+	}
+	if hasNaN(e.Value) {
+		e.failed = true
+		fail(e.T, e.Logger, fmt.Sprintf("Expect %v to be %v but NaN is not comparable", e.Value, expectation))
+		return e
+	}
+	result := doCompare(reflect.Zero(reflect.TypeOf(e.Value)).Interface(), e.Value)
+	if result != expectedResult {
+		e.failed = true
+		fail(e.T, e.Logger, buildFailMessage("Expect %v to be "+expectation, result == notComparable, e.Value))
+	}
+	return e
+}
+
+// IsBetween fails test if value is lexically lower than lowerBound or greater than upperBound
+func (e *StringExpectation) IsBetween(lowerBound, upperBound string) *StringExpectation {
+	e.E.IsBetween(lowerBound, upperBound)
+	return e
+}
+
+// IsStrictlyBetween fails test if value is not lexically greater than lowerBound and lower than upperBound
+func (e *StringExpectation) IsStrictlyBetween(lowerBound, upperBound string) *StringExpectation {
+	e.E.IsStrictlyBetween(lowerBound, upperBound)
+	return e
+}
@@ -0,0 +1,111 @@
+package expectations_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type RangeTestCase struct {
+	Fn       func(*expectations.Expectation)
+	Value    interface{}
+	Succeeds bool
+}
+
+func TestRangeExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	testCases := []RangeTestCase{
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(1, 10) }, 1, true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(1, 10) }, 10, true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(1, 10) }, 0, false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(1, 10) }, 11, false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(1, 10) }, uint(5), false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(1.5, 2.5) }, 2.0, true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween("a", "c") }, "b", true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsStrictlyBetween(1, 10) }, 5, true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsStrictlyBetween(1, 10) }, 1, false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsStrictlyBetween(1, 10) }, 10, false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsPositive() }, int8(1), true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsPositive() }, 0.0, false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsPositive() }, "a", false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsNegative() }, -0.5, true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsNegative() }, uint(0), false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsZero() }, uint16(0), true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsZero() }, 3, false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(0.0, 1.0) }, math.NaN(), false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(math.NaN(), 1.0) }, 0.5, false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsZero() }, math.NaN(), false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsNegative() }, float32(math.NaN()), false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsGreaterOrEqual(0.0) }, math.NaN(), false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsPositive() }, celsius(5), true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsNegative() }, celsius(5), false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(celsius(0), celsius(10)) }, celsius(5), true},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(celsius(0), celsius(10)) }, celsius(11), false},
+		RangeTestCase{func(e *expectations.Expectation) { e.IsBetween(name("a"), name("c")) }, name("b"), true},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(et.ExpectThat(testCase.Value))
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed: %v should be %v", i, testCase.Value, testCase.Succeeds)
+		}
+	}
+}
+
+func TestRangeShowsViolatedBound(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThat(12).IsBetween(1, 10)
+	et.ExpectThat(0).IsBetween(1, 10)
+	et.ExpectThat(10).IsStrictlyBetween(1, 10)
+	et.ExpectThatString("delta").IsBetween("alpha", "charlie")
+	et.ExpectThat(math.NaN()).IsBetween(0.0, 1.0)
+	et.ExpectThat(math.NaN()).IsZero()
+
+	for _, expectedMessage := range []string{
+		"Expect 12 to be between 1 and 10 but it is greater than the upper bound 10",
+		"Expect 0 to be between 1 and 10 but it is lower than the lower bound 1",
+		"Expect 10 to be strictly between 1 and 10 but it is equal to the upper bound 10",
+		"Expect delta to be between alpha and charlie but it is greater than the upper bound charlie",
+		"Expect NaN to be between 0 and 1 but NaN is not comparable",
+		"Expect NaN to be zero but NaN is not comparable",
+	} {
+		if !strings.Contains(loggerMock.logs, expectedMessage) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedMessage)
+		}
+	}
+}
+
+func TestTypedRangeExpectations(t *testing.T) {
+	eT := expectations.NewT(t)
+	expectations.ThatNumber(&eT, celsius(21.5)).IsBetween(20, 22).IsStrictlyBetween(21, 21.6).IsPositive()
+	expectations.ThatNumber(&eT, -3).IsNegative()
+	expectations.ThatNumber(&eT, uint8(0)).IsZero()
+	expectations.ThatString(&eT, name("joe")).IsBetween("jim", "john")
+
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	eT = expectations.NewTWithLogger(tMock, &loggerMock)
+	expectations.ThatNumber(&eT, celsius(30)).IsBetween(20, 22)
+	if !strings.Contains(loggerMock.logs, "Expect 30 to be between 20 and 22 but it is greater than the upper bound 22") {
+		t.Errorf("Expected '%v' to show the violated bound", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	expectations.ThatNumber(&eT, celsius(math.NaN())).IsBetween(20, 22)
+	if !strings.Contains(loggerMock.logs, "Expect NaN to be between 20 and 22 but NaN is not comparable") {
+		t.Errorf("Expected '%v' to reject NaN", loggerMock.logs)
+	}
+	tMock.reset()
+	expectations.ThatNumber(&eT, math.NaN()).IsZero()
+	if !tMock.HasBeenCalled {
+		t.Error("Expect NaN not to be zero")
+	}
+}
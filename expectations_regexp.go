@@ -0,0 +1,90 @@
+package expectations
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ===================== Regular expressions ==============================
+
+// compile fails the test if value is not a string or if pattern is not a valid regular expression.
+// An anchored expression must match the whole value.
+func (e *StringExpectation) compile(pattern string, anchored bool) (string, *regexp.Regexp, bool) {
+	valueString, valueOk := e.E.Value.(string)
+	if !valueOk {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to be a string", showTypeInfos, e.E.Value))
+		return "", nil, false
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to be a valid regular expression: %v", pattern, err))
+		return "", nil, false
+	}
+	if anchored {
+		pattern = `^(?:` + pattern + `)$`
+	}
+	return valueString, regexp.MustCompile(pattern), true
+}
+
+// Matches fails test if the whole value is not matched by the regular expression pattern
+func (e *StringExpectation) Matches(pattern string) *StringExpectation {
+	if e.E.skip() {
+		return e
+	}
+	if value, expression, ok := e.compile(pattern, true); ok && !expression.MatchString(value) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to match %q", value, pattern))
+	}
+	return e
+}
+
+// DoesNotMatch fails test if the whole value is matched by the regular expression pattern
+func (e *StringExpectation) DoesNotMatch(pattern string) *StringExpectation {
+	if e.E.skip() {
+		return e
+	}
+	if value, expression, ok := e.compile(pattern, true); ok && expression.MatchString(value) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to not match %q", value, pattern))
+	}
+	return e
+}
+
+// ContainsMatch fails test if no part of value is matched by the regular expression pattern
+func (e *StringExpectation) ContainsMatch(pattern string) *StringExpectation {
+	if e.E.skip() {
+		return e
+	}
+	if value, expression, ok := e.compile(pattern, false); ok && !expression.MatchString(value) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to contain a match of %q", value, pattern))
+	}
+	return e
+}
+
+// MatchesWithGroups fails test if the whole value is not matched by the regular expression pattern.
+// It builds an Expectation for the map of the named groups to their matched values.
+func (e *StringExpectation) MatchesWithGroups(pattern string) *MapExpectation {
+	if e.E.skip() {
+		return &MapExpectation{e.E}
+	}
+	value, expression, ok := e.compile(pattern, true)
+	if !ok {
+		return &MapExpectation{e.E.abort()}
+	}
+	match := expression.FindStringSubmatch(value)
+	if match == nil {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to match %q", value, pattern))
+		return &MapExpectation{e.E.abort()}
+	}
+
+	groups := make(map[string]string)
+	for i, name := range expression.SubexpNames() {
+		if name != "" {
+			groups[name] = match[i]
+		}
+	}
+	return &MapExpectation{e.E.child(groups)}
+}
@@ -0,0 +1,77 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type RegexpTestCase struct {
+	Fn       func(string) *expectations.StringExpectation
+	Pattern  string
+	Succeeds bool
+}
+
+func TestRegexpExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	actualValue := "order-4711"
+	expect := et.ExpectThatString(actualValue)
+
+	testCases := []RegexpTestCase{
+		RegexpTestCase{expect.Matches, `order-\d+`, true},
+		RegexpTestCase{expect.Matches, `a|order-4711`, true},
+		RegexpTestCase{expect.Matches, `\d+`, false},
+		RegexpTestCase{expect.Matches, `(`, false},
+		RegexpTestCase{expect.DoesNotMatch, `\d+`, true},
+		RegexpTestCase{expect.DoesNotMatch, `order-\d+`, false},
+		RegexpTestCase{expect.DoesNotMatch, `[`, false},
+		RegexpTestCase{expect.ContainsMatch, `\d+`, true},
+		RegexpTestCase{expect.ContainsMatch, `^\d+`, false},
+	}
+
+	for _, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(testCase.Pattern)
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test failed: %v %v %v should be %v", actualValue, functionName(testCase.Fn), testCase.Pattern, testCase.Succeeds)
+		}
+		expect.Reset()
+	}
+}
+
+func TestRegexpInvalidPatternFails(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatString("foo").Matches("(")
+	if !strings.Contains(loggerMock.logs, "Expect \"(\" to be a valid regular expression: error parsing regexp: missing closing )") {
+		t.Errorf("Expected '%v' to show the invalid pattern", loggerMock.logs)
+	}
+}
+
+func TestRegexpMatchesWithGroups(t *testing.T) {
+	et := expectations.NewT(t)
+
+	et.ExpectThatString("2024-03-17").
+		MatchesWithGroups(`(?P<year>\d{4})-(?P<month>\d{2})-(\d{2})`).
+		ContainsOnlyKeys("year", "month").
+		ContainsEntry("year", "2024").
+		Get("month").Equals("03")
+}
+
+func TestRegexpMatchesWithGroupsStopsWithoutMatch(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatString("today").MatchesWithGroups(`(?P<year>\d{4})`).ContainsKey("year")
+	if !strings.Contains(loggerMock.logs, `Expect "today" to match "(?P<year>\\d{4})"`) {
+		t.Errorf("Expected '%v' to show the failed match", loggerMock.logs)
+	}
+	if strings.Contains(loggerMock.logs, "to contain keys") {
+		t.Errorf("Expected '%v' to stop after the failed match", loggerMock.logs)
+	}
+}
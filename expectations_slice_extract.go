@@ -0,0 +1,159 @@
+package expectations
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ===================== Slice extraction ==============================
+
+// Extracting builds an Expectation for the values of field of all elements. Nested fields are separated by dots,
+// for example Address.City. Pointers are followed and maps with string keys are accessed by key.
+// The new slice has the type of the extracted values if all of them have the same type.
+func (e *SliceExpectation) Extracting(field string) *SliceExpectation {
+	if e.E.skip() {
+		return e
+	}
+	if !e.isSlice() {
+		return &SliceExpectation{e.E.abort()}
+	}
+
+	var extracted []reflect.Value
+	for i, value := range toSlice(e.E.Value) {
+		fieldValue, err := extractField(reflect.ValueOf(value), field)
+		if err != nil {
+			e.E.failed = true
+			fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect element [%v] of %v to have field %v but %v", i, e.E.Value, field, err))
+			return &SliceExpectation{e.E.abort()}
+		}
+		extracted = append(extracted, fieldValue)
+	}
+	return &SliceExpectation{e.E.child(sliceOf(extracted))}
+}
+
+// extractField follows the dot separated path starting at value
+func extractField(value reflect.Value, path string) (reflect.Value, error) {
+	for _, name := range strings.Split(path, ".") {
+		for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			if value.IsNil() {
+				return reflect.Value{}, fmt.Errorf("%v is nil before %v", value.Type(), name)
+			}
+			value = value.Elem()
+		}
+		switch value.Kind() {
+		case reflect.Struct:
+			field, found := value.Type().FieldByName(name)
+			if !found {
+				return reflect.Value{}, fmt.Errorf("%v has no field %v", value.Type(), name)
+			}
+			fieldValue, err := value.FieldByIndexErr(field.Index)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("field %v of %v is promoted through a nil embedded pointer", name, value.Type())
+			}
+			if !fieldValue.CanInterface() {
+				return reflect.Value{}, fmt.Errorf("field %v of %v is not exported", name, value.Type())
+			}
+			value = fieldValue
+		case reflect.Map:
+			if value.Type().Key().Kind() != reflect.String {
+				return reflect.Value{}, fmt.Errorf("%v has no string keys", value.Type())
+			}
+			entry := value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key()))
+			if !entry.IsValid() {
+				return reflect.Value{}, fmt.Errorf("%v has no key %v", value.Type(), name)
+			}
+			value = entry
+		default:
+			if !value.IsValid() {
+				return reflect.Value{}, fmt.Errorf("the value is nil before %v", name)
+			}
+			return reflect.Value{}, fmt.Errorf("%v is neither a struct nor a map", value.Type())
+		}
+	}
+	return value, nil
+}
+
+// sliceOf builds a slice of the common type of values, or a []interface{} if the types differ
+func sliceOf(values []reflect.Value) interface{} {
+	elementType := reflect.TypeOf((*interface{})(nil)).Elem()
+	for i, value := range values {
+		if value.Kind() == reflect.Interface && !value.IsNil() {
+			values[i] = value.Elem()
+		}
+	}
+	if len(values) > 0 && values[0].Kind() != reflect.Interface {
+		elementType = values[0].Type()
+		for _, value := range values {
+			if value.Type() != elementType {
+				elementType = reflect.TypeOf((*interface{})(nil)).Elem()
+				break
+			}
+		}
+	}
+	return sliceOfType(elementType, values)
+}
+
+func sliceOfType(elementType reflect.Type, values []reflect.Value) interface{} {
+	slice := reflect.MakeSlice(reflect.SliceOf(elementType), len(values), len(values))
+	for i, value := range values {
+		slice.Index(i).Set(value)
+	}
+	return slice.Interface()
+}
+
+// checkFunc fails the test if fn is not a function accepting the elements of the slice and returning a single value.
+// A predicate must return a bool.
+func (e *SliceExpectation) checkFunc(fn interface{}, predicate bool) bool {
+	fnType := reflect.TypeOf(fn)
+	elementType := reflect.TypeOf(e.E.Value).Elem()
+	if fnType == nil || fnType.Kind() != reflect.Func || fnType.NumIn() != 1 || fnType.NumOut() != 1 ||
+		!elementType.AssignableTo(fnType.In(0)) || (predicate && fnType.Out(0).Kind() != reflect.Bool) {
+		signature := fmt.Sprintf("func(%v) R", elementType)
+		if predicate {
+			signature = fmt.Sprintf("func(%v) bool", elementType)
+		}
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %T to be a %v", fn, signature))
+		return false
+	}
+	return true
+}
+
+// ExtractingFunc builds an Expectation for the values returned by fn for all elements.
+// fn must be a func(T) R where T is the element type of the slice, the new slice is a []R.
+func (e *SliceExpectation) ExtractingFunc(fn interface{}) *SliceExpectation {
+	if e.E.skip() {
+		return e
+	}
+	if !e.isSlice() || !e.checkFunc(fn, false) {
+		return &SliceExpectation{e.E.abort()}
+	}
+
+	slice := reflect.ValueOf(e.E.Value)
+	var extracted []reflect.Value
+	for i := 0; i < slice.Len(); i++ {
+		extracted = append(extracted, reflect.ValueOf(fn).Call([]reflect.Value{slice.Index(i)})[0])
+	}
+	return &SliceExpectation{e.E.child(sliceOfType(reflect.TypeOf(fn).Out(0), extracted))}
+}
+
+// Filtered builds an Expectation for the elements for which predicate returns true.
+// predicate must be a func(T) bool where T is the element type of the slice, the new slice has the same element type.
+func (e *SliceExpectation) Filtered(predicate interface{}) *SliceExpectation {
+	if e.E.skip() {
+		return e
+	}
+	if !e.isSlice() || !e.checkFunc(predicate, true) {
+		return &SliceExpectation{e.E.abort()}
+	}
+
+	slice := reflect.ValueOf(e.E.Value)
+	var filtered []reflect.Value
+	for i := 0; i < slice.Len(); i++ {
+		if reflect.ValueOf(predicate).Call([]reflect.Value{slice.Index(i)})[0].Bool() {
+			filtered = append(filtered, slice.Index(i))
+		}
+	}
+	return &SliceExpectation{e.E.child(sliceOfType(slice.Type().Elem(), filtered))}
+}
@@ -0,0 +1,80 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+var people = []person{
+	person{Name: "Joe", Address: &address{"Main", "Berlin"}, age: 30},
+	person{Name: "Jim", Address: &address{"Side", "Hamburg"}, age: 17},
+	person{Name: "Jane", Address: &address{"Main", "Berlin"}, age: 45},
+}
+
+type employee struct {
+	*address
+	Name string
+}
+
+func TestSliceExtractingDemo(t *testing.T) {
+	et := expectations.NewT(t)
+
+	et.ExpectThatSlice(people).Extracting("Name").ContainsExactly("Joe", "Jim", "Jane")
+	et.ExpectThatSlice(people).Extracting("Address.City").ContainsOnly("Berlin", "Hamburg")
+	et.ExpectThatSlice([]*person{&people[0]}).Extracting("Address.Street").ContainsExactly("Main")
+	et.ExpectThatSlice([]map[string]interface{}{{"id": 1}, {"id": "2"}}).Extracting("id").ContainsExactly(1, "2")
+	et.ExpectThatSlice(people).ExtractingFunc(func(p person) int { return len(p.Name) }).ContainsExactly(3, 3, 4)
+	et.ExpectThatSlice(people).Filtered(func(p person) bool { return p.Address.City == "Berlin" }).
+		HasSize(2).Extracting("Name").ContainsExactly("Joe", "Jane")
+	et.ExpectThatSlice([]int{1, 2, 3, 4}).Filtered(func(i interface{}) bool { return i.(int)%2 == 0 }).ContainsExactly(2, 4)
+	et.ExpectThatSlice([]employee{{&address{"Main", "Berlin"}, "Joe"}}).Extracting("City").ContainsExactly("Berlin")
+}
+
+func TestSliceExtractingKeepsTheFieldType(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	et.ExpectThatSlice(people).Extracting("Address").First().Equals(&address{"Main", "Berlin"})
+	et.ExpectThatSlice(people).Extracting("Name").Contains("Jim")
+	et.ExpectThatSlice(people).Filtered(func(p person) bool { return false }).IsEmpty()
+	if tMock.HasBeenCalled {
+		t.Error("Expect the extracted slices to have the type of the fields")
+	}
+}
+
+func TestSliceExtractingFailures(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatSlice(people).Extracting("Address.Zip").Contains("12345")
+	if !strings.Contains(loggerMock.logs, "to have field Address.Zip but expectations_test.address has no field Zip") {
+		t.Errorf("Expected '%v' to report the missing field", loggerMock.logs)
+	}
+	if strings.Count(loggerMock.logs, "--- ") != 1 {
+		t.Errorf("Expected '%v' to stop after the missing field", loggerMock.logs)
+	}
+
+	for _, testCase := range []struct {
+		Fn       func()
+		Expected string
+	}{
+		{func() { et.ExpectThatSlice(people).Extracting("age") }, "field age of expectations_test.person is not exported"},
+		{func() { et.ExpectThatSlice([]person{person{Name: "Jo"}}).Extracting("Address.City") }, "Expect element [0] of"},
+		{func() { et.ExpectThatSlice(people).Extracting("Name.First") }, "string is neither a struct nor a map"},
+		{func() { et.ExpectThatSlice([]employee{{Name: "Jo"}}).Extracting("City") }, "field City of expectations_test.employee is promoted through a nil embedded pointer"},
+		{func() { et.ExpectThatSlice([]employee{{Name: "Jo"}}).IsSortedByField("City") }, "is promoted through a nil embedded pointer"},
+		{func() { et.ExpectThatSlice([]employee{{Name: "Jo"}}).HasUniqueBy("City") }, "is promoted through a nil embedded pointer"},
+		{func() { et.ExpectThatSlice(people).ExtractingFunc(func(a address) string { return a.City }) }, "Expect func(expectations_test.address) string to be a func(expectations_test.person) R"},
+		{func() { et.ExpectThatSlice(people).Filtered(func(p person) int { return 1 }) }, "to be a func(expectations_test.person) bool"},
+	} {
+		tMock.reset()
+		loggerMock.Reset()
+		testCase.Fn()
+		if !tMock.HasBeenCalled || !strings.Contains(loggerMock.logs, testCase.Expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, testCase.Expected)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package expectations
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ===================== Slice contents and order ==============================
+
+// isSlice fails the test if the value is not a slice or an array
+func (e *SliceExpectation) isSlice() bool {
+	if e.E.Value == nil || !(reflect.TypeOf(e.E.Value).Kind() == reflect.Slice || reflect.TypeOf(e.E.Value).Kind() == reflect.Array) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v %T to be a slice", e.E.Value, e.E.Value))
+		return false
+	}
+	return true
+}
+
+// elementDiff describes how the elements of a slice deviate from the expected elements
+type elementDiff struct {
+	missing   []int
+	extra     []int
+	misplaced [][2]int
+}
+
+func (d elementDiff) isEmpty() bool {
+	return len(d.missing) == 0 && len(d.extra) == 0 && len(d.misplaced) == 0
+}
+
+// format renders one line for each missing, extra and misplaced element
+func (d elementDiff) format(expected, actual []interface{}) string {
+	var lines []string
+	for _, i := range d.missing {
+		lines = append(lines, fmt.Sprintf("\tmissing %v, expected at [%v]", formatElement(expected[i]), i))
+	}
+	for _, i := range d.extra {
+		lines = append(lines, fmt.Sprintf("\tunexpected %v at [%v]", formatElement(actual[i]), i))
+	}
+	for _, indexes := range d.misplaced {
+		lines = append(lines, fmt.Sprintf("\tmisplaced %v, expected at [%v] but found at [%v]", formatElement(expected[indexes[0]]), indexes[0], indexes[1]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatElement(element interface{}) string {
+	return formatValue(reflect.ValueOf(element))
+}
+
+// diffElements matches the elements in order first. Remaining elements found at another position are misplaced.
+func diffElements(expected, actual []interface{}) elementDiff {
+	matchedExpected := make([]bool, len(expected))
+	matchedActual := make([]bool, len(actual))
+	for _, pair := range longestCommonSubsequence(expected, actual) {
+		matchedExpected[pair[0]] = true
+		matchedActual[pair[1]] = true
+	}
+
+	var d elementDiff
+	for i := range expected {
+		if matchedExpected[i] {
+			continue
+		}
+		if j := indexOfUnmatched(actual, matchedActual, expected[i]); j >= 0 {
+			matchedActual[j] = true
+			d.misplaced = append(d.misplaced, [2]int{i, j})
+		} else {
+			d.missing = append(d.missing, i)
+		}
+	}
+	for j := range actual {
+		if !matchedActual[j] {
+			d.extra = append(d.extra, j)
+		}
+	}
+	return d
+}
+
+// diffElementCounts compares expected and actual as multisets, the order is ignored
+func diffElementCounts(expected, actual []interface{}) elementDiff {
+	matchedActual := make([]bool, len(actual))
+	var d elementDiff
+	for i := range expected {
+		if j := indexOfUnmatched(actual, matchedActual, expected[i]); j >= 0 {
+			matchedActual[j] = true
+		} else {
+			d.missing = append(d.missing, i)
+		}
+	}
+	for j := range actual {
+		if !matchedActual[j] {
+			d.extra = append(d.extra, j)
+		}
+	}
+	return d
+}
+
+func indexOfUnmatched(values []interface{}, matched []bool, value interface{}) int {
+	for i := range values {
+		if !matched[i] && deepEqual(value, values[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+func indexOf(values []interface{}, value interface{}) int {
+	for i := range values {
+		if deepEqual(value, values[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// longestCommonSubsequence returns the index pairs of the longest list of elements found in the same order in both slices
+func longestCommonSubsequence(expected, actual []interface{}) [][2]int {
+	lengths := make([][]int, len(expected)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(actual)+1)
+	}
+	for i := len(expected) - 1; i >= 0; i-- {
+		for j := len(actual) - 1; j >= 0; j-- {
+			if deepEqual(expected[i], actual[j]) {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var pairs [][2]int
+	for i, j := 0, 0; i < len(expected) && j < len(actual); {
+		switch {
+		case deepEqual(expected[i], actual[j]):
+			pairs = append(pairs, [2]int{i, j})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return pairs
+}
+
+// ContainsExactly checks if the slice contains the expected values in the same order and nothing else
+func (e *SliceExpectation) ContainsExactly(expectedValues ...interface{}) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	values := toSlice(e.E.Value)
+	if d := diffElements(expectedValues, values); !d.isEmpty() {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain exactly %v\n", !checkTypesMatch(values, expectedValues), e.E.Value, expectedValues)+d.format(expectedValues, values))
+	}
+	return e
+}
+
+// ContainsExactlyInAnyOrder checks if the slice contains the expected values in any order and nothing else.
+// Values expected multiple times must occur as often in the slice.
+func (e *SliceExpectation) ContainsExactlyInAnyOrder(expectedValues ...interface{}) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	values := toSlice(e.E.Value)
+	if d := diffElementCounts(expectedValues, values); !d.isEmpty() {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain exactly in any order %v\n", !checkTypesMatch(values, expectedValues), e.E.Value, expectedValues)+d.format(expectedValues, values))
+	}
+	return e
+}
+
+// ContainsOnly checks if the slice contains all expected values and no other values. Order and duplicates are ignored.
+func (e *SliceExpectation) ContainsOnly(expectedValues ...interface{}) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	values := toSlice(e.E.Value)
+	var d elementDiff
+	for i, expectedValue := range expectedValues {
+		if indexOf(values, expectedValue) < 0 {
+			d.missing = append(d.missing, i)
+		}
+	}
+	for j, value := range values {
+		if indexOf(expectedValues, value) < 0 {
+			d.extra = append(d.extra, j)
+		}
+	}
+
+	if !d.isEmpty() {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain only %v\n", !checkTypesMatch(values, expectedValues), e.E.Value, expectedValues)+d.format(expectedValues, values))
+	}
+	return e
+}
+
+// ContainsSequence checks if the slice contains the expected values next to each other and in the same order
+func (e *SliceExpectation) ContainsSequence(sequence ...interface{}) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	values := toSlice(e.E.Value)
+	bestStart, bestMatches := 0, -1
+	for start := 0; start+len(sequence) <= len(values); start++ {
+		matches := 0
+		for i := range sequence {
+			if deepEqual(sequence[i], values[start+i]) {
+				matches++
+			}
+		}
+		if matches == len(sequence) {
+			return e
+		}
+		if matches > bestMatches {
+			bestStart, bestMatches = start, matches
+		}
+	}
+
+	e.E.failed = true
+	if bestMatches < 0 {
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain sequence %v", !checkTypesMatch(values, sequence), e.E.Value, sequence)+fmt.Sprintf(" but it has only %v element(s)", len(values)))
+		return e
+	}
+	var lines []string
+	for i := range sequence {
+		if !deepEqual(sequence[i], values[bestStart+i]) {
+			lines = append(lines, fmt.Sprintf("\t[%v]: want %v, got %v", bestStart+i, formatElement(sequence[i]), formatElement(values[bestStart+i])))
+		}
+	}
+	fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain sequence %v", !checkTypesMatch(values, sequence), e.E.Value, sequence)+fmt.Sprintf(", the closest match starts at [%v]\n", bestStart)+strings.Join(lines, "\n"))
+	return e
+}
+
+// ContainsSubsequence checks if the slice contains the expected values in the same order, other values may be in between
+func (e *SliceExpectation) ContainsSubsequence(subsequence ...interface{}) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	values := toSlice(e.E.Value)
+	var lines []string
+	next := 0
+	for i, expectedValue := range subsequence {
+		found := indexOf(values[next:], expectedValue)
+		if found >= 0 {
+			next += found + 1
+			continue
+		}
+		if earlier := indexOf(values, expectedValue); earlier >= 0 && next == 0 {
+			lines = append(lines, fmt.Sprintf("\tmisplaced %v, expected before all matched elements but found at [%v]", formatElement(expectedValue), earlier))
+		} else if earlier >= 0 {
+			lines = append(lines, fmt.Sprintf("\tmisplaced %v, expected after [%v] but found at [%v]", formatElement(expectedValue), next-1, earlier))
+		} else {
+			lines = append(lines, fmt.Sprintf("\tmissing %v, expected at position %v of the subsequence", formatElement(expectedValue), i))
+		}
+	}
+
+	if len(lines) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain subsequence %v\n", !checkTypesMatch(values, subsequence), e.E.Value, subsequence)+strings.Join(lines, "\n"))
+	}
+	return e
+}
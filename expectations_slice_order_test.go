@@ -0,0 +1,100 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+func TestSliceOrderExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	actualValue := []int{1, 2, 3, 2}
+	expect := et.ExpectThatSlice(actualValue)
+
+	testCases := []ArrayTestCase{
+		ArrayTestCase{expect.ContainsExactly, []interface{}{1, 2, 3, 2}, true},
+		ArrayTestCase{expect.ContainsExactly, []interface{}{1, 2, 2, 3}, false},
+		ArrayTestCase{expect.ContainsExactly, []interface{}{1, 2, 3}, false},
+		ArrayTestCase{expect.ContainsExactly, []interface{}{1, 2, 3, 2, 4}, false},
+		ArrayTestCase{expect.ContainsExactly, []interface{}{1, 2, 3, int64(2)}, false},
+		ArrayTestCase{expect.ContainsExactlyInAnyOrder, []interface{}{2, 2, 3, 1}, true},
+		ArrayTestCase{expect.ContainsExactlyInAnyOrder, []interface{}{2, 3, 1}, false},
+		ArrayTestCase{expect.ContainsExactlyInAnyOrder, []interface{}{2, 3, 1, 1}, false},
+		ArrayTestCase{expect.ContainsOnly, []interface{}{3, 2, 1}, true},
+		ArrayTestCase{expect.ContainsOnly, []interface{}{3, 2, 1, 1}, true},
+		ArrayTestCase{expect.ContainsOnly, []interface{}{3, 2}, false},
+		ArrayTestCase{expect.ContainsOnly, []interface{}{3, 2, 1, 4}, false},
+		ArrayTestCase{expect.ContainsSequence, []interface{}{2, 3}, true},
+		ArrayTestCase{expect.ContainsSequence, []interface{}{3, 2}, true},
+		ArrayTestCase{expect.ContainsSequence, []interface{}{}, true},
+		ArrayTestCase{expect.ContainsSequence, []interface{}{1, 3}, false},
+		ArrayTestCase{expect.ContainsSequence, []interface{}{1, 2, 3, 2, 1}, false},
+		ArrayTestCase{expect.ContainsSubsequence, []interface{}{1, 3}, true},
+		ArrayTestCase{expect.ContainsSubsequence, []interface{}{1, 2, 2}, true},
+		ArrayTestCase{expect.ContainsSubsequence, []interface{}{3, 1}, false},
+		ArrayTestCase{expect.ContainsSubsequence, []interface{}{1, 5}, false},
+	}
+
+	for _, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(testCase.ExpectedValue...)
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test failed: %v %v %v should be %v", actualValue, functionName(testCase.Fn), testCase.ExpectedValue, testCase.Succeeds)
+		}
+		expect.Reset()
+	}
+}
+
+func TestSliceOrderDemo(t *testing.T) {
+	et := expectations.NewT(t)
+
+	steps := []string{"checkout", "build", "test", "deploy"}
+	et.ExpectThatSlice(steps).ContainsExactly("checkout", "build", "test", "deploy").ContainsOnly("deploy", "test", "build", "checkout")
+	et.ExpectThatSlice(steps).ContainsSequence("build", "test").ContainsSubsequence("checkout", "deploy")
+	et.ExpectThatSlice([]person{person{Name: "Joe"}, person{Name: "Jim"}}).ContainsExactlyInAnyOrder(person{Name: "Jim"}, person{Name: "Joe"})
+}
+
+func TestSliceContainsExactlyShowsElementDiff(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatSlice([]string{"a", "c", "b", "x"}).ContainsExactly("a", "b", "c", "d")
+	for _, expected := range []string{
+		"Expect [a c b x] to contain exactly [a b c d]",
+		`missing "d", expected at [3]`,
+		`unexpected "x" at [3]`,
+		`misplaced "b", expected at [1] but found at [2]`,
+	} {
+		if !strings.Contains(loggerMock.logs, expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expected)
+		}
+	}
+}
+
+func TestSliceSequencesShowElementDiff(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatSlice([]int{1, 2, 4, 3}).ContainsSequence(2, 3)
+	if !strings.Contains(loggerMock.logs, "Expect [1 2 4 3] to contain sequence [2 3], the closest match starts at [1]\n\t[2]: want 3, got 4") {
+		t.Errorf("Expected '%v' to show the closest match", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatSlice([]int{1, 2, 3}).ContainsSubsequence(2, 1, 7)
+	for _, expected := range []string{"misplaced 1, expected after [1] but found at [0]", "missing 7, expected at position 2 of the subsequence"} {
+		if !strings.Contains(loggerMock.logs, expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expected)
+		}
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatSlice(nil).ContainsExactly(1)
+	if !strings.Contains(loggerMock.logs, "to be a slice") {
+		t.Errorf("Expected '%v' to reject nil", loggerMock.logs)
+	}
+}
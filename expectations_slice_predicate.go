@@ -0,0 +1,89 @@
+package expectations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ===================== Slice predicates ==============================
+
+// checkElements runs requirements for every element with an Expectation whose failures are collected.
+// It returns the failures of every element, elements without failures have no entry.
+func (e *SliceExpectation) checkElements(requirements func(e *Expectation)) map[int]*failureCollector {
+	failures := map[int]*failureCollector{}
+	for i, value := range toSlice(e.E.Value) {
+		collector := &failureCollector{}
+		requirements(&Expectation{T: collector, Logger: collector, Value: value, soft: e.E.soft})
+		if collector.hasFailures() {
+			failures[i] = collector
+		}
+	}
+	return failures
+}
+
+// formatElementFailures lists the failures of each element in the order of the slice
+func formatElementFailures(size int, failures map[int]*failureCollector) string {
+	var lines []string
+	for i := 0; i < size; i++ {
+		if collector, ok := failures[i]; ok {
+			for _, failure := range collector.failures {
+				lines = append(lines, fmt.Sprintf("\t[%v]: %v", i, strings.Replace(failure, "\n", "\n\t\t", -1)))
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// AllSatisfy fails test if an element does not meet the requirements. The requirements are expressed as
+// expectations on the Expectation passed for each element, all failing elements are reported.
+func (e *SliceExpectation) AllSatisfy(requirements func(e *Expectation)) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	size := len(toSlice(e.E.Value))
+	if failures := e.checkElements(requirements); len(failures) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect all elements of %v to satisfy the requirements but %v of %v did not:\n%v", e.E.Value, len(failures), size, formatElementFailures(size, failures)))
+	}
+	return e
+}
+
+// AnySatisfy fails test if no element meets the requirements, see AllSatisfy
+func (e *SliceExpectation) AnySatisfy(requirements func(e *Expectation)) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	size := len(toSlice(e.E.Value))
+	if failures := e.checkElements(requirements); len(failures) == size {
+		e.E.failed = true
+		message := fmt.Sprintf("Expect any element of %v to satisfy the requirements but none did", e.E.Value)
+		if size > 0 {
+			message += ":\n" + formatElementFailures(size, failures)
+		}
+		fail(e.E.T, e.E.Logger, message)
+	}
+	return e
+}
+
+// NoneSatisfy fails test if an element meets the requirements, see AllSatisfy
+func (e *SliceExpectation) NoneSatisfy(requirements func(e *Expectation)) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	values := toSlice(e.E.Value)
+	failures := e.checkElements(requirements)
+	var lines []string
+	for i, value := range values {
+		if _, failed := failures[i]; !failed {
+			lines = append(lines, fmt.Sprintf("\t[%v]: %v", i, formatElement(value)))
+		}
+	}
+	if len(lines) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect no element of %v to satisfy the requirements but %v of %v did:\n%v", e.E.Value, len(lines), len(values), strings.Join(lines, "\n")))
+	}
+	return e
+}
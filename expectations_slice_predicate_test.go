@@ -0,0 +1,84 @@
+package expectations_test
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type PredicateTestCase struct {
+	Fn       func(func(*expectations.Expectation)) *expectations.SliceExpectation
+	Values   []int
+	Succeeds bool
+}
+
+func TestSlicePredicates(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	isPositive := func(e *expectations.Expectation) { e.IsGreater(0) }
+
+	testCases := []PredicateTestCase{
+		PredicateTestCase{et.ExpectThatSlice([]int{1, 2}).AllSatisfy, []int{1, 2}, true},
+		PredicateTestCase{et.ExpectThatSlice([]int{1, -2}).AllSatisfy, []int{1, -2}, false},
+		PredicateTestCase{et.ExpectThatSlice([]int{}).AllSatisfy, []int{}, true},
+		PredicateTestCase{et.ExpectThatSlice([]int{-1, 2}).AnySatisfy, []int{-1, 2}, true},
+		PredicateTestCase{et.ExpectThatSlice([]int{-1, -2}).AnySatisfy, []int{-1, -2}, false},
+		PredicateTestCase{et.ExpectThatSlice([]int{}).AnySatisfy, []int{}, false},
+		PredicateTestCase{et.ExpectThatSlice([]int{-1, -2}).NoneSatisfy, []int{-1, -2}, true},
+		PredicateTestCase{et.ExpectThatSlice([]int{-1, 2}).NoneSatisfy, []int{-1, 2}, false},
+		PredicateTestCase{et.ExpectThatSlice([]int{}).NoneSatisfy, []int{}, true},
+	}
+
+	for _, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(isPositive)
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test failed: %v %v should be %v", testCase.Values, functionName(testCase.Fn), testCase.Succeeds)
+		}
+	}
+}
+
+func TestSlicePredicatesDemo(t *testing.T) {
+	et := expectations.NewT(t)
+
+	names := []string{"Joe", "Jim", "Jane"}
+	et.ExpectThatSlice(names).AllSatisfy(func(e *expectations.Expectation) {
+		e.String_().StartsWith("J").DoesNotContain(" ")
+	}).AnySatisfy(func(e *expectations.Expectation) {
+		e.Equals("Jim")
+	}).NoneSatisfy(func(e *expectations.Expectation) {
+		e.String_().EndsWith("y")
+	})
+}
+
+func TestAllSatisfyListsFailedElements(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	_, _, line, _ := runtime.Caller(0)
+	et.ExpectThatSlice([]int{5, -1, 7, -3}).AllSatisfy(func(e *expectations.Expectation) {
+		e.IsGreater(0)
+	})
+	for _, expected := range []string{
+		"Expect all elements of [5 -1 7 -3] to satisfy the requirements but 2 of 4 did not:",
+		"\t[1]: line " + strconv.Itoa(line+2) + ": Expect -1 to be greater than 0",
+		"\t[3]: line " + strconv.Itoa(line+2) + ": Expect -3 to be greater than 0",
+	} {
+		if !strings.Contains(loggerMock.logs, expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expected)
+		}
+	}
+	if strings.Count(loggerMock.logs, "--- ") != 1 {
+		t.Errorf("Expected '%v' to report all elements in one failure", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatSlice([]int{5, -1}).NoneSatisfy(func(e *expectations.Expectation) { e.IsGreater(0) })
+	if !strings.Contains(loggerMock.logs, "Expect no element of [5 -1] to satisfy the requirements but 1 of 2 did:\n\t[0]: 5") {
+		t.Errorf("Expected '%v' to list the matching elements", loggerMock.logs)
+	}
+}
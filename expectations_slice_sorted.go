@@ -0,0 +1,148 @@
+package expectations
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ===================== Sorted slices ==============================
+
+// isOrdered reports if compareOrder can order the value: numbers, strings and time.Time
+func isOrdered(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+	if _, ok := value.(time.Time); ok {
+		return true
+	}
+	valueType := reflect.TypeOf(value)
+	switch valueType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return valueType.PkgPath() == ""
+	}
+	return false
+}
+
+// compareOrder compares like doCompare, times are ordered too
+func compareOrder(expected, actual interface{}) uint {
+	expectedTime, expectedIsTime := expected.(time.Time)
+	actualTime, actualIsTime := actual.(time.Time)
+	if !expectedIsTime || !actualIsTime {
+		return doCompare(expected, actual)
+	}
+	switch {
+	case actualTime.After(expectedTime):
+		return greater
+	case actualTime.Before(expectedTime):
+		return lower
+	}
+	return equal
+}
+
+// checkSorted fails the test at the first pair of neighbours which is out of order.
+// keys are the compared values which are shown for elements sorted by a field.
+func (e *SliceExpectation) checkSorted(order string, keys []interface{}, outOfOrder func(previous, next int) bool) {
+	for i := 1; i < len(keys); i++ {
+		if outOfOrder(i-1, i) {
+			e.E.failed = true
+			fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be sorted %v but [%v] %v and [%v] %v are out of order", e.E.Value, order, i-1, formatElement(keys[i-1]), i, formatElement(keys[i])))
+			return
+		}
+	}
+}
+
+// checkOrdered fails the test if compareOrder cannot order keys, NaN is neither lower, equal nor greater
+func (e *SliceExpectation) checkOrdered(keys []interface{}, description string) bool {
+	for i, key := range keys {
+		if !isOrdered(key) || reflect.TypeOf(key) != reflect.TypeOf(keys[0]) {
+			e.E.failed = true
+			fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v of %v to be numbers, strings or times of the same type but [%v] is %v (%T), use IsSortedBy instead", description, e.E.Value, i, key, key))
+			return false
+		}
+		if hasNaN(key) {
+			e.E.failed = true
+			fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v of %v to be sorted but [%v] is NaN which is not comparable", description, e.E.Value, i))
+			return false
+		}
+	}
+	return true
+}
+
+// IsSorted fails test if the elements are not in ascending order. Elements must be numbers, strings or times.
+func (e *SliceExpectation) IsSorted() *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+	values := toSlice(e.E.Value)
+	if e.checkOrdered(values, "the elements") {
+		e.checkSorted("ascending", values, func(previous, next int) bool {
+			return compareOrder(values[previous], values[next]) == lower
+		})
+	}
+	return e
+}
+
+// IsSortedDescending fails test if the elements are not in descending order. Elements must be numbers, strings or times.
+func (e *SliceExpectation) IsSortedDescending() *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+	values := toSlice(e.E.Value)
+	if e.checkOrdered(values, "the elements") {
+		e.checkSorted("descending", values, func(previous, next int) bool {
+			return compareOrder(values[previous], values[next]) == greater
+		})
+	}
+	return e
+}
+
+// IsSortedBy fails test if the elements are not sorted according to less.
+// less must be a func(a, b T) bool where T is the element type of the slice, like for sort.Slice
+// it reports if a must be sorted before b.
+func (e *SliceExpectation) IsSortedBy(less interface{}) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+	lessType := reflect.TypeOf(less)
+	elementType := reflect.TypeOf(e.E.Value).Elem()
+	if lessType == nil || lessType.Kind() != reflect.Func || lessType.NumIn() != 2 || lessType.NumOut() != 1 ||
+		!elementType.AssignableTo(lessType.In(0)) || !elementType.AssignableTo(lessType.In(1)) || lessType.Out(0).Kind() != reflect.Bool {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %T to be a func(a, b %v) bool", less, elementType))
+		return e
+	}
+
+	slice := reflect.ValueOf(e.E.Value)
+	e.checkSorted("by the given function", toSlice(e.E.Value), func(previous, next int) bool {
+		return reflect.ValueOf(less).Call([]reflect.Value{slice.Index(next), slice.Index(previous)})[0].Bool()
+	})
+	return e
+}
+
+// IsSortedByField fails test if the elements are not in ascending order of field, see Extracting for the
+// supported fields. The fields must be numbers, strings or times.
+func (e *SliceExpectation) IsSortedByField(field string) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	var keys []interface{}
+	for i, value := range toSlice(e.E.Value) {
+		key, err := extractField(reflect.ValueOf(value), field)
+		if err != nil {
+			e.E.failed = true
+			fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect element [%v] of %v to have field %v but %v", i, e.E.Value, field, err))
+			return e
+		}
+		keys = append(keys, key.Interface())
+	}
+	if e.checkOrdered(keys, "the fields "+field) {
+		e.checkSorted("by "+field, keys, func(previous, next int) bool {
+			return compareOrder(keys[previous], keys[next]) == lower
+		})
+	}
+	return e
+}
@@ -0,0 +1,96 @@
+package expectations_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/laliluna/expectations"
+)
+
+type event struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+type SortedTestCase struct {
+	Fn       func(*expectations.SliceExpectation) *expectations.SliceExpectation
+	Value    interface{}
+	Succeeds bool
+}
+
+func TestSliceSortedExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	events := []event{{"a", start}, {"b", start.Add(time.Hour)}, {"c", start.Add(time.Hour)}}
+	sortedByName := func(e *expectations.SliceExpectation) *expectations.SliceExpectation {
+		return e.IsSortedBy(func(a, b event) bool { return a.Name < b.Name })
+	}
+	sortedByInts := func(e *expectations.SliceExpectation) *expectations.SliceExpectation {
+		return e.IsSortedBy(func(a, b int) bool { return a < b })
+	}
+	sortedByField := func(field string) func(*expectations.SliceExpectation) *expectations.SliceExpectation {
+		return func(e *expectations.SliceExpectation) *expectations.SliceExpectation { return e.IsSortedByField(field) }
+	}
+
+	testCases := []SortedTestCase{
+		{(*expectations.SliceExpectation).IsSorted, []int{1, 2, 2, 5}, true},
+		{(*expectations.SliceExpectation).IsSorted, []int{}, true},
+		{(*expectations.SliceExpectation).IsSorted, []int{1, 3, 2}, false},
+		{(*expectations.SliceExpectation).IsSorted, []string{"a", "b"}, true},
+		{(*expectations.SliceExpectation).IsSorted, []time.Time{start, start.Add(-time.Second)}, false},
+		{(*expectations.SliceExpectation).IsSorted, []interface{}{1, 2.0}, false},
+		{(*expectations.SliceExpectation).IsSorted, []celsius{1, 2}, false},
+		{(*expectations.SliceExpectation).IsSorted, []address{{}}, false},
+		{(*expectations.SliceExpectation).IsSortedDescending, []float64{3.5, 2, 2, -1}, true},
+		{(*expectations.SliceExpectation).IsSortedDescending, []float64{3.5, 4}, false},
+		{(*expectations.SliceExpectation).IsSorted, []float64{1, math.NaN(), 0}, false},
+		{(*expectations.SliceExpectation).IsSortedDescending, []float32{float32(math.NaN())}, false},
+		{sortedByName, events, true},
+		{sortedByName, []event{events[1], events[0]}, false},
+		{sortedByInts, events, false},
+		{sortedByField("CreatedAt"), events, true},
+		{sortedByField("CreatedAt"), []event{events[2], events[0]}, false},
+		{sortedByField("Name"), []*event{&events[0], &events[1]}, true},
+		{sortedByField("UpdatedAt"), events, false},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(et.ExpectThatSlice(testCase.Value))
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed: %v should be %v", i, testCase.Value, testCase.Succeeds)
+		}
+	}
+}
+
+func TestSliceSortedNamesFirstPairOutOfOrder(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatSlice([]int{1, 4, 3, 2}).IsSorted()
+	if !strings.Contains(loggerMock.logs, "Expect [1 4 3 2] to be sorted ascending but [1] 4 and [2] 3 are out of order") {
+		t.Errorf("Expected '%v' to name the first pair out of order", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatSlice([]event{{"a", time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)}, {"b", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}}).IsSortedByField("CreatedAt")
+	if !strings.Contains(loggerMock.logs, "to be sorted by CreatedAt but [0] 2024-03-02 00:00:00 +0000 UTC and [1] 2024-03-01 00:00:00 +0000 UTC are out of order") {
+		t.Errorf("Expected '%v' to show the fields out of order", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatSlice([]interface{}{"a", 1}).IsSorted()
+	if !strings.Contains(loggerMock.logs, "to be numbers, strings or times of the same type but [1] is 1 (int), use IsSortedBy instead") {
+		t.Errorf("Expected '%v' to reject mixed types", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatSlice([]float64{1, math.NaN(), 0}).IsSorted()
+	if !strings.Contains(loggerMock.logs, "Expect the elements of [1 NaN 0] to be sorted but [1] is NaN which is not comparable") {
+		t.Errorf("Expected '%v' to reject NaN", loggerMock.logs)
+	}
+}
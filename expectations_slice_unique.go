@@ -0,0 +1,105 @@
+package expectations
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ===================== Slice duplicates ==============================
+
+// findDuplicates groups the indexes of equal keys, only keys found more than once are returned.
+// Keys are compared with deepEqual, so they need not be comparable with ==.
+func findDuplicates(keys []interface{}) [][]int {
+	var groups [][]int
+	grouped := make([]bool, len(keys))
+	for i := range keys {
+		if grouped[i] {
+			continue
+		}
+		group := []int{i}
+		for j := i + 1; j < len(keys); j++ {
+			if !grouped[j] && deepEqual(keys[i], keys[j]) {
+				grouped[j] = true
+				group = append(group, j)
+			}
+		}
+		if len(group) > 1 {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// formatDuplicates renders one line for each duplicated key with all of its indexes
+func formatDuplicates(keys []interface{}, duplicates [][]int) string {
+	var lines []string
+	for _, group := range duplicates {
+		lines = append(lines, fmt.Sprintf("\t%v at %v", formatElement(keys[group[0]]), group))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// HasNoDuplicates fails test if an element occurs more than once
+func (e *SliceExpectation) HasNoDuplicates() *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	values := toSlice(e.E.Value)
+	if duplicates := findDuplicates(values); len(duplicates) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to have no duplicates but found:\n%v", e.E.Value, formatDuplicates(values, duplicates)))
+	}
+	return e
+}
+
+// HasDuplicates fails test if every element occurs only once
+func (e *SliceExpectation) HasDuplicates() *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	if len(findDuplicates(toSlice(e.E.Value))) == 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to have duplicates but all elements are unique", e.E.Value))
+	}
+	return e
+}
+
+// HasUniqueBy fails test if two elements have the same key. key is either the name of a field, see Extracting
+// for the supported fields, or a func(T) K where T is the element type of the slice.
+func (e *SliceExpectation) HasUniqueBy(key interface{}) *SliceExpectation {
+	if e.E.skip() || !e.isSlice() {
+		return e
+	}
+
+	var keys []interface{}
+	description := "the given function"
+	if field, ok := key.(string); ok {
+		description = field
+		for i, value := range toSlice(e.E.Value) {
+			fieldValue, err := extractField(reflect.ValueOf(value), field)
+			if err != nil {
+				e.E.failed = true
+				fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect element [%v] of %v to have field %v but %v", i, e.E.Value, field, err))
+				return e
+			}
+			keys = append(keys, fieldValue.Interface())
+		}
+	} else {
+		if !e.checkFunc(key, false) {
+			return e
+		}
+		slice := reflect.ValueOf(e.E.Value)
+		for i := 0; i < slice.Len(); i++ {
+			keys = append(keys, reflect.ValueOf(key).Call([]reflect.Value{slice.Index(i)})[0].Interface())
+		}
+	}
+
+	if duplicates := findDuplicates(keys); len(duplicates) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be unique by %v but found:\n%v", e.E.Value, description, formatDuplicates(keys, duplicates)))
+	}
+	return e
+}
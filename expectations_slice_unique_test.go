@@ -0,0 +1,77 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type UniqueTestCase struct {
+	Fn       func(*expectations.SliceExpectation) *expectations.SliceExpectation
+	Value    interface{}
+	Succeeds bool
+}
+
+func TestSliceDuplicateExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	joe := person{Name: "Joe", Tags: []string{"a"}, Address: &address{City: "Berlin"}}
+	jane := person{Name: "Jane", Tags: []string{"a"}, Address: &address{City: "Berlin"}}
+	joeAgain := person{Name: "Joe", Tags: []string{"a"}, Address: &address{City: "Berlin"}}
+	uniqueByName := func(e *expectations.SliceExpectation) *expectations.SliceExpectation { return e.HasUniqueBy("Name") }
+	uniqueByAddress := func(e *expectations.SliceExpectation) *expectations.SliceExpectation {
+		return e.HasUniqueBy(func(p person) *address { return p.Address })
+	}
+
+	testCases := []UniqueTestCase{
+		{(*expectations.SliceExpectation).HasNoDuplicates, []int{1, 2, 3}, true},
+		{(*expectations.SliceExpectation).HasNoDuplicates, []int{}, true},
+		{(*expectations.SliceExpectation).HasNoDuplicates, []int{1, 2, 1}, false},
+		{(*expectations.SliceExpectation).HasNoDuplicates, [][]string{{"a"}, {"b"}}, true},
+		{(*expectations.SliceExpectation).HasNoDuplicates, [][]string{{"a"}, {"a"}}, false},
+		{(*expectations.SliceExpectation).HasNoDuplicates, []person{joe, jane}, true},
+		{(*expectations.SliceExpectation).HasNoDuplicates, []person{joe, joeAgain}, false},
+		{(*expectations.SliceExpectation).HasDuplicates, []person{joe, joeAgain}, true},
+		{(*expectations.SliceExpectation).HasDuplicates, []person{joe, jane}, false},
+		{(*expectations.SliceExpectation).HasDuplicates, []int{}, false},
+		{uniqueByName, []person{joe, jane}, true},
+		{uniqueByName, []person{joe, jane, joeAgain}, false},
+		{uniqueByName, []int{1}, false},
+		{uniqueByAddress, []person{joe}, true},
+		{uniqueByAddress, []person{joe, jane}, false},
+		{uniqueByAddress, []string{"a"}, false},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(et.ExpectThatSlice(testCase.Value))
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed: %v should be %v", i, testCase.Value, testCase.Succeeds)
+		}
+	}
+}
+
+func TestSliceDuplicatesAreReportedWithAllIndexes(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatSlice([]string{"a", "b", "a", "c", "b", "a"}).HasNoDuplicates()
+	if !strings.Contains(loggerMock.logs, "Expect [a b a c b a] to have no duplicates but found:\n\t\"a\" at [0 2 5]\n\t\"b\" at [1 4]") {
+		t.Errorf("Expected '%v' to list the duplicates with their indexes", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	people := []person{{Name: "Joe"}, {Name: "Jane"}, {Name: "Joe"}}
+	et.ExpectThatSlice(people).HasUniqueBy("Name")
+	if !strings.Contains(loggerMock.logs, "to be unique by Name but found:\n\t\"Joe\" at [0 2]") {
+		t.Errorf("Expected '%v' to list the duplicated names", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatSlice(people).HasUniqueBy("Age")
+	if !strings.Contains(loggerMock.logs, "Expect element [0] of") || !strings.Contains(loggerMock.logs, "to have field Age but") {
+		t.Errorf("Expected '%v' to report the missing field", loggerMock.logs)
+	}
+}
@@ -0,0 +1,153 @@
+package expectations
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ===================== Inline snapshots ==============================
+
+// lineShift records that the lines after line of the original source moved by delta lines
+type lineShift struct {
+	line  int
+	delta int
+}
+
+var (
+	snapshotLock sync.Mutex
+	// snapshotShifts tracks the rewritten lines of each file. Frames still report the lines of the compiled source.
+	snapshotShifts = map[string][]lineShift{}
+)
+
+// MatchesInlineSnapshot fails test if value differs from snapshot, a unified diff is shown on failure.
+// If Update or the environment variable UPDATE_GOLDEN is true, the string literal
+// passed as snapshot is replaced with value in the source of the test.
+func (e *StringExpectation) MatchesInlineSnapshot(snapshot string) *StringExpectation {
+	if e.E.skip() {
+		return e
+	}
+	valueString, valueOk := e.E.Value.(string)
+	if !valueOk {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to be a string", showTypeInfos, e.E.Value))
+		return e
+	}
+	e.E.matchesInlineSnapshot(snapshot, valueString)
+	return e
+}
+
+// MatchesInlineSnapshot fails test if the serialized value differs from snapshot.
+// Values are serialized like in MatchesGolden, see StringExpectation.MatchesInlineSnapshot for updating the snapshot.
+func (e *Expectation) MatchesInlineSnapshot(snapshot string) *Expectation {
+	if e.skip() {
+		return e
+	}
+	e.matchesInlineSnapshot(snapshot, serialize(e.Value))
+	return e
+}
+
+func (e *Expectation) matchesInlineSnapshot(snapshot, actual string) {
+	if snapshot == actual {
+		return
+	}
+	if updateRequested() {
+		frame := callerFrame()
+		if err := updateInlineSnapshot(frame.File, frame.Line, actual); err != nil {
+			e.failed = true
+			fail(e.T, e.Logger, fmt.Sprintf("Expect inline snapshot in %v to be updated: %v", frame.File, err))
+		}
+		return
+	}
+	e.failed = true
+	fail(e.T, e.Logger, buildMultiLineFailMessage("Expect value to match the inline snapshot, run the test with UPDATE_GOLDEN=true to accept the changes:", snapshot, actual, e.diffContextLines()))
+}
+
+// updateInlineSnapshot replaces the snapshot literal of the call of MatchesInlineSnapshot found in line of file
+func updateInlineSnapshot(file string, line int, actual string) error {
+	snapshotLock.Lock()
+	defer snapshotLock.Unlock()
+
+	shift := 0
+	for _, s := range snapshotShifts[file] {
+		if s.line < line {
+			shift += s.delta
+		}
+	}
+
+	fileSet := token.NewFileSet()
+	source, err := parser.ParseFile(fileSet, file, nil, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	call := findSnapshotCall(fileSet, source, line+shift)
+	if call == nil {
+		return fmt.Errorf("no call of MatchesInlineSnapshot found in line %v", line)
+	}
+	literal, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return fmt.Errorf("the snapshot in line %v must be a string literal", line)
+	}
+
+	end := fileSet.Position(literal.End()).Line
+	delta := strings.Count(snapshotLiteral(actual), "\n") - strings.Count(literal.Value, "\n")
+	literal.Value = snapshotLiteral(actual)
+
+	var buffer bytes.Buffer
+	config := printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}
+	if err := config.Fprint(&buffer, fileSet, source); err != nil {
+		return err
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(file, buffer.Bytes(), info.Mode()); err != nil {
+		return err
+	}
+	if delta != 0 {
+		snapshotShifts[file] = append(snapshotShifts[file], lineShift{end - shift, delta})
+	}
+	return nil
+}
+
+// findSnapshotCall returns the call of MatchesInlineSnapshot in line. Calls spanning multiple lines
+// are found by the line of the method name.
+func findSnapshotCall(fileSet *token.FileSet, source *ast.File, line int) *ast.CallExpr {
+	var found *ast.CallExpr
+	ast.Inspect(source, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || found != nil {
+			return found == nil
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if ok && selector.Sel.Name == "MatchesInlineSnapshot" && len(call.Args) == 1 &&
+			(fileSet.Position(selector.Sel.Pos()).Line == line || fileSet.Position(call.Lparen).Line == line) {
+			found = call
+		}
+		return true
+	})
+	return found
+}
+
+// snapshotLiteral prefers raw strings for multi-line values and values containing quotes or backslashes
+func snapshotLiteral(value string) string {
+	if !strings.ContainsAny(value, "\n\"\\") || strings.Contains(value, "`") || !utf8.ValidString(value) {
+		return strconv.Quote(value)
+	}
+	for _, r := range value {
+		if r != '\n' && r != '\t' && !unicode.IsPrint(r) {
+			return strconv.Quote(value)
+		}
+	}
+	return "`" + value + "`"
+}
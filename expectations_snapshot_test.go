@@ -0,0 +1,104 @@
+package expectations_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+func TestInlineSnapshotDemo(t *testing.T) {
+	et := expectations.NewT(t)
+
+	et.ExpectThatString("Hello World").MatchesInlineSnapshot("Hello World")
+	et.ExpectThat(address{"Main", "Berlin"}).MatchesInlineSnapshot(`expectations_test.address{
+	Street: "Main",
+	City: "Berlin",
+}`)
+}
+
+func TestInlineSnapshotShowsDiff(t *testing.T) {
+	withoutUpdate(t)
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatString("a\nc").MatchesInlineSnapshot("a\nb")
+	for _, expected := range []string{"Expect value to match the inline snapshot, run the test with UPDATE_GOLDEN=true to accept the changes:", "- b", "+ c"} {
+		if !strings.Contains(loggerMock.logs, expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expected)
+		}
+	}
+}
+
+const snapshotTest = `package demo
+
+import (
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+func TestDemo(t *testing.T) {
+	et := expectations.NewT(t)
+	et.ExpectThatString("first\nsecond").MatchesInlineSnapshot("")
+	et.ExpectThat([]int{1}).
+		MatchesInlineSnapshot("") // comment
+	for i := 0; i < 2; i++ {
+		et.ExpectThatString("say \"hi\"").MatchesInlineSnapshot("")
+	}
+}
+`
+
+const updatedSnapshotTest = `package demo
+
+import (
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+func TestDemo(t *testing.T) {
+	et := expectations.NewT(t)
+	et.ExpectThatString("first\nsecond").MatchesInlineSnapshot(` + "`first\nsecond`" + `)
+	et.ExpectThat([]int{1}).
+		MatchesInlineSnapshot(` + "`[]int{\n\t1,\n}`" + `) // comment
+	for i := 0; i < 2; i++ {
+		et.ExpectThatString("say \"hi\"").MatchesInlineSnapshot(` + "`say \"hi\"`" + `)
+	}
+}
+`
+
+func TestInlineSnapshotUpdatesSource(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if testing.Short() || err != nil {
+		t.Skip("needs the go tool to run a test in update mode")
+	}
+	moduleDirectory, _ := os.Getwd()
+	directory := t.TempDir()
+	files := map[string]string{
+		"go.mod":       "module demo\n\ngo 1.21\n\nrequire github.com/laliluna/expectations v0.0.0\n\nreplace github.com/laliluna/expectations => " + moduleDirectory + "\n",
+		"demo_test.go": snapshotTest,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, update := range []string{"true", "false"} {
+		command := exec.Command(goTool, "test", "-count=1", ".")
+		command.Dir = directory
+		command.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod", expectations.UpdateGoldenEnv+"="+update)
+		if output, err := command.CombinedOutput(); err != nil {
+			t.Fatalf("go test with %v=%v failed: %v\n%s", expectations.UpdateGoldenEnv, update, err, output)
+		}
+	}
+
+	et := expectations.NewT(t)
+	content, _ := os.ReadFile(filepath.Join(directory, "demo_test.go"))
+	et.ExpectThatString(string(content)).Equals(updatedSnapshotTest)
+}
@@ -0,0 +1,38 @@
+package expectations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// failureCollector buffers failures instead of reporting them. It is used as FailFunction and Logger.
+type failureCollector struct {
+	failures []string
+}
+
+// Fail does nothing, the failure is recorded by Log
+func (c *failureCollector) Fail() {}
+
+// Log records a failure message
+func (c *failureCollector) Log(message string) {
+	c.failures = append(c.failures, message)
+}
+
+func (c *failureCollector) hasFailures() bool {
+	return len(c.failures) > 0
+}
+
+// String lists all recorded failures, one per line
+func (c *failureCollector) String() string {
+	return "\t" + strings.Replace(strings.Join(c.failures, "\n"), "\n", "\n\t", -1)
+}
+
+// Soft runs fn with an Et whose expectations do not stop at the first failure of a chain.
+// All failures are collected and reported together once fn returns, calling Reset is not required.
+func (aEt *Et) Soft(fn func(s *Et)) {
+	collector := &failureCollector{}
+	fn(&Et{T: collector, Logger: collector, soft: true})
+	if collector.hasFailures() {
+		fail(aEt.T, aEt.Logger, fmt.Sprintf("%v soft expectation(s) failed:\n%v", len(collector.failures), collector))
+	}
+}
@@ -0,0 +1,62 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+func TestSoftReportsAllFailures(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.Soft(func(s *expectations.Et) {
+		s.ExpectThat(2).Equals(3).IsLower(1)
+		s.ExpectThatString("Hello").StartsWith("Bye").EndsWith("lo")
+		s.ExpectThatSlice([]int{1}).HasSize(2).Contains(5)
+	})
+
+	if !tMock.HasBeenCalled {
+		t.Error("Soft should fail")
+	}
+	if strings.Count(loggerMock.logs, "in line") != 1 {
+		t.Errorf("Expected '%v' to be reported in one block", loggerMock.logs)
+	}
+	for _, expectedMessage := range []string{
+		"5 soft expectation(s) failed",
+		"line 16: Expect 2 to equal 3",
+		"Expect 2 to equal 3",
+		"Expect 2 to be lower than 1",
+		"Expect Hello (string) to start with Bye (string)",
+		"Expect len of [1] []int to be 2 and not 1",
+	} {
+		if !strings.Contains(loggerMock.logs, expectedMessage) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedMessage)
+		}
+	}
+}
+
+func TestSoftSucceeds(t *testing.T) {
+	et := expectations.NewT(t)
+
+	et.Soft(func(s *expectations.Et) {
+		s.ExpectThat(2).Equals(2).IsLower(3)
+		s.ExpectThatSlice([]int{1}).HasSize(1).First().Equals(1)
+	})
+}
+
+func TestSoftStopsChainWithoutElement(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.Soft(func(s *expectations.Et) {
+		s.ExpectThatSlice([]int{1}).Second().Equals(2).IsGreater(7)
+	})
+
+	if !strings.Contains(loggerMock.logs, "1 soft expectation(s) failed") {
+		t.Errorf("Expected '%v' to only report the missing element", loggerMock.logs)
+	}
+}
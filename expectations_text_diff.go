@@ -0,0 +1,240 @@
+package expectations
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// defaultDiffContext is the number of unchanged lines shown around each change of a multi-line string diff
+// unless WithDiffContext configures another number. Longer runs of unchanged lines are left out.
+const defaultDiffContext = 3
+
+const (
+	unchangedLine = ' '
+	removedLine   = '-'
+	addedLine     = '+'
+)
+
+// lineEdit is a single line of a diff. Line numbers start at 1, 0 marks a line missing on that side.
+type lineEdit struct {
+	kind         byte
+	text         string
+	expectedLine int
+	actualLine   int
+}
+
+// unifiedDiff describes how to turn expected into actual, line by line
+func unifiedDiff(expected, actual string, context int) string {
+	edits := diffLines(strings.Split(expected, "\n"), strings.Split(actual, "\n"))
+	width := len(fmt.Sprint(len(edits)))
+
+	result := []string{"--- expected", "+++ actual"}
+	for _, hunk := range hunks(edits, context) {
+		result = append(result, hunkHeader(hunk))
+		for _, edit := range hunk {
+			result = append(result, fmt.Sprintf("%*v %*v %c %v", width, lineNumber(edit.expectedLine), width, lineNumber(edit.actualLine), edit.kind, edit.text))
+		}
+	}
+	return strings.Join(result, "\n")
+}
+
+func lineNumber(line int) string {
+	if line == 0 {
+		return ""
+	}
+	return fmt.Sprint(line)
+}
+
+// diffLines computes the edits with the longest common subsequence of both texts
+func diffLines(expected, actual []string) []lineEdit {
+	prefix := 0
+	for prefix < len(expected) && prefix < len(actual) && expected[prefix] == actual[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(expected)-prefix && suffix < len(actual)-prefix && expected[len(expected)-1-suffix] == actual[len(actual)-1-suffix] {
+		suffix++
+	}
+	a := expected[prefix : len(expected)-suffix]
+	b := actual[prefix : len(actual)-suffix]
+
+	// common[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	common := make([][]int32, len(a)+1)
+	for i := range common {
+		common[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else if common[i+1][j] >= common[i][j+1] {
+				common[i][j] = common[i+1][j]
+			} else {
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+
+	var edits []lineEdit
+	for i := 0; i < prefix; i++ {
+		edits = append(edits, lineEdit{unchangedLine, expected[i], i + 1, i + 1})
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			edits = append(edits, lineEdit{unchangedLine, a[i], prefix + i + 1, prefix + j + 1})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && common[i+1][j] >= common[i][j+1]):
+			edits = append(edits, lineEdit{removedLine, a[i], prefix + i + 1, 0})
+			i++
+		default:
+			edits = append(edits, lineEdit{addedLine, b[j], 0, prefix + j + 1})
+			j++
+		}
+	}
+	for k := 0; k < suffix; k++ {
+		edits = append(edits, lineEdit{unchangedLine, expected[len(expected)-suffix+k], len(expected) - suffix + k + 1, len(actual) - suffix + k + 1})
+	}
+	return edits
+}
+
+// hunks groups the changes together with context unchanged lines before and after them
+func hunks(edits []lineEdit, context int) [][]lineEdit {
+	var result [][]lineEdit
+	start, end := -1, -1
+	for i, edit := range edits {
+		if edit.kind == unchangedLine {
+			continue
+		}
+		if start >= 0 && i-context > end {
+			result = append(result, edits[start:end])
+			start = -1
+		}
+		if start < 0 {
+			start = max(0, i-context)
+		}
+		end = min(len(edits), i+context+1)
+	}
+	if start >= 0 {
+		result = append(result, edits[start:end])
+	}
+	return result
+}
+
+func hunkHeader(hunk []lineEdit) string {
+	expectedStart, expectedCount, actualStart, actualCount := 0, 0, 0, 0
+	for _, edit := range hunk {
+		if edit.expectedLine > 0 {
+			if expectedStart == 0 {
+				expectedStart = edit.expectedLine
+			}
+			expectedCount++
+		}
+		if edit.actualLine > 0 {
+			if actualStart == 0 {
+				actualStart = edit.actualLine
+			}
+			actualCount++
+		}
+	}
+	return fmt.Sprintf("@@ -%v,%v +%v,%v @@", expectedStart, expectedCount, actualStart, actualCount)
+}
+
+func isMultiLine(values ...interface{}) bool {
+	for _, value := range values {
+		if s, ok := value.(string); ok && strings.Contains(s, "\n") {
+			return true
+		}
+	}
+	return false
+}
+
+func buildMultiLineFailMessage(message string, expected, actual string, context int) string {
+	return message + "\n\t" + strings.Replace(unifiedDiff(expected, actual, context), "\n", "\n\t", -1)
+}
+
+// WithDiffContext sets the number of unchanged lines shown around each change if a multi-line string differs
+func (e *Expectation) WithDiffContext(lines int) *Expectation {
+	e.diffContext = &lines
+	return e
+}
+
+// WithDiffContext sets the number of unchanged lines shown around each change if a multi-line string differs
+func (e *StringExpectation) WithDiffContext(lines int) *StringExpectation {
+	e.E.WithDiffContext(lines)
+	return e
+}
+
+func (e *Expectation) diffContextLines() int {
+	if e.diffContext == nil || *e.diffContext < 0 {
+		return defaultDiffContext
+	}
+	return *e.diffContext
+}
+
+// visualizeRunes renders each rune of s, invisible characters like tabs, non-breaking or zero-width spaces and
+// trailing spaces are shown as escapes
+func visualizeRunes(s string) []string {
+	runes := []rune(s)
+	trailingSpaces := len(runes)
+	for trailingSpaces > 0 && runes[trailingSpaces-1] == ' ' {
+		trailingSpaces--
+	}
+
+	result := make([]string, len(runes))
+	for i, r := range runes {
+		switch {
+		case r == ' ' && i >= trailingSpaces:
+			result[i] = `\x20`
+		case r == '\\':
+			result[i] = `\\`
+		case r == ' ' || strconv.IsPrint(r):
+			result[i] = string(r)
+		default:
+			quoted := strconv.QuoteRune(r)
+			result[i] = quoted[1 : len(quoted)-1]
+		}
+	}
+	return result
+}
+
+// describeRune names the rune at index of runes, including its code point to reveal look-alikes
+func describeRune(runes []rune, index int) string {
+	if index >= len(runes) {
+		return "end of string"
+	}
+	return fmt.Sprintf("%q (%U)", runes[index], runes[index])
+}
+
+// buildCharacterDiff marks the first differing rune of two single-line strings.
+// equalRunes decides if two runes are equal, e.g. ignoring the case.
+func buildCharacterDiff(expected, actual string, equalRunes func(a, b rune) bool) string {
+	expectedRunes := []rune(expected)
+	actualRunes := []rune(actual)
+	index := 0
+	for index < len(expectedRunes) && index < len(actualRunes) && equalRunes(expectedRunes[index], actualRunes[index]) {
+		index++
+	}
+
+	visibleActual := visualizeRunes(actual)
+	column := 0
+	for _, rendered := range visibleActual[:index] {
+		column += utf8.RuneCountInString(rendered)
+	}
+	return fmt.Sprintf("\texpected: %v\n\tactual:   %v\n\t          %v^ first difference at rune %v: expected %v, actual %v",
+		strings.Join(visualizeRunes(expected), ""), strings.Join(visibleActual, ""), strings.Repeat(" ", column),
+		index, describeRune(expectedRunes, index), describeRune(actualRunes, index))
+}
+
+func equalRune(a, b rune) bool {
+	return a == b
+}
+
+func equalRuneIgnoringCase(a, b rune) bool {
+	return unicode.ToLower(a) == unicode.ToLower(b)
+}
@@ -0,0 +1,136 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+func numberedLines(from, to int, replacements map[int]string) string {
+	var lines []string
+	for i := from; i <= to; i++ {
+		if replacement, ok := replacements[i]; ok {
+			if replacement != "" {
+				lines = append(lines, replacement)
+			}
+			continue
+		}
+		lines = append(lines, "line "+string(rune('a'+i%26)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestMultiLineStringShowsUnifiedDiff(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	expected := "first\nsecond\nthird\nfourth"
+	actual := "first\nchanged\nthird\nfourth\nfifth"
+	et.ExpectThatString(actual).Equals(expected)
+
+	expectedDiff := strings.Join([]string{
+		"Expect multi-line string to equal the expected value:",
+		"\t--- expected",
+		"\t+++ actual",
+		"\t@@ -1,4 +1,5 @@",
+		"\t1 1   first",
+		"\t2   - second",
+		"\t  2 + changed",
+		"\t3 3   third",
+		"\t4 4   fourth",
+		"\t  5 + fifth",
+	}, "\n")
+	if !strings.Contains(loggerMock.logs, expectedDiff) {
+		t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedDiff)
+	}
+}
+
+func TestMultiLineStringDiffLeavesOutUnchangedLines(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	expected := numberedLines(1, 40, nil)
+	actual := numberedLines(1, 40, map[int]string{5: "changed", 30: ""})
+	et.ExpectThatString(actual).Equals(expected)
+
+	for _, expectedLine := range []string{"@@ -2,7 +2,7 @@", "@@ -27,7 +27,6 @@", " 5    - line f", "    5 + changed", "30    - line e"} {
+		if !strings.Contains(loggerMock.logs, expectedLine) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedLine)
+		}
+	}
+	if strings.Contains(loggerMock.logs, "line q") {
+		t.Errorf("Expected '%v' to leave out unchanged lines", loggerMock.logs)
+	}
+}
+
+func TestMultiLineStringDiffContextIsConfigurable(t *testing.T) {
+	t.Parallel()
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatString("a\nb\nc").WithDiffContext(0).Equals("a\nx\nc")
+	if !strings.Contains(loggerMock.logs, "@@ -2,1 +2,1 @@") || strings.Contains(loggerMock.logs, "  a") {
+		t.Errorf("Expected '%v' to show no context", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatString("a\nb\nc").Equals("a\nx\nc")
+	if !strings.Contains(loggerMock.logs, "@@ -1,3 +1,3 @@") {
+		t.Errorf("Expected '%v' to show the default context for other expectations", loggerMock.logs)
+	}
+}
+
+func TestSingleLineStringMarksFirstDifference(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatString("foo\tbar ").Equals("foo bar")
+
+	expectedMessage := strings.Join([]string{
+		"\texpected: foo bar",
+		"\tactual:   foo\\tbar\\x20",
+		"\t             ^ first difference at rune 3: expected ' ' (U+0020), actual '\\t' (U+0009)",
+	}, "\n")
+	if !strings.Contains(loggerMock.logs, expectedMessage) {
+		t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedMessage)
+	}
+}
+
+func TestSingleLineStringShowsInvisibleCharacters(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatString("a b\r‍c").EqualsIgnoringCase("A B\rC")
+
+	expectedMessage := strings.Join([]string{
+		"\texpected: A B\\rC",
+		"\tactual:   a\\u00a0b\\r\\u200dc",
+		"\t           ^ first difference at rune 1: expected ' ' (U+0020), actual '\\u00a0' (U+00A0)",
+	}, "\n")
+	if !strings.Contains(loggerMock.logs, expectedMessage) {
+		t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedMessage)
+	}
+}
+
+func TestSingleLineStringShowsLookAlikes(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatString("pаssword").Equals("password")
+	if !strings.Contains(loggerMock.logs, "first difference at rune 1: expected 'a' (U+0061), actual 'а' (U+0430)") {
+		t.Errorf("Expected '%v' to show the code points", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatString("pass").Equals("password")
+	if !strings.Contains(loggerMock.logs, "first difference at rune 4: expected 'w' (U+0077), actual end of string") {
+		t.Errorf("Expected '%v' to show the end of the string", loggerMock.logs)
+	}
+}
@@ -0,0 +1,398 @@
+package expectations
+
+import "fmt"
+
+// Number is the constraint for all types supported by ThatNumber
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// ===================== Typed values ==============================
+
+// TypedExpectation is an Expectation whose methods only accept values of the type T
+type TypedExpectation[T any] struct {
+	E *Expectation
+}
+
+// That builds a typed Expectation. Comparing values of different types is rejected by the compiler.
+func That[T any](aEt *Et, value T) *TypedExpectation[T] {
+	return &TypedExpectation[T]{aEt.ExpectThat(value)}
+}
+
+// Reset sets the failed flag to false so that further expectations can be executed
+func (e *TypedExpectation[T]) Reset() {
+	e.E.Reset()
+}
+
+// Equals fails test if expected is not equal to value
+func (e *TypedExpectation[T]) Equals(expected T) *TypedExpectation[T] {
+	e.E.Equals(expected)
+	return e
+}
+
+// DoesNotEqual fails test if expected is equal to value
+func (e *TypedExpectation[T]) DoesNotEqual(expected T) *TypedExpectation[T] {
+	e.E.DoesNotEqual(expected)
+	return e
+}
+
+// IsNil fails test if value is not nil
+func (e *TypedExpectation[T]) IsNil() *TypedExpectation[T] {
+	e.E.IsNil()
+	return e
+}
+
+// IsNotNil fails test if value is nil
+func (e *TypedExpectation[T]) IsNotNil() *TypedExpectation[T] {
+	e.E.IsNotNil()
+	return e
+}
+
+// ===================== Typed numbers ==============================
+
+// NumberExpectation allows to express expectations on numbers of the type T
+type NumberExpectation[T Number] struct {
+	E *Expectation
+}
+
+// ThatNumber builds a typed Expectation for numbers
+func ThatNumber[T Number](aEt *Et, value T) *NumberExpectation[T] {
+	return &NumberExpectation[T]{aEt.ExpectThat(value)}
+}
+
+// Reset sets the failed flag to false so that further expectations can be executed
+func (e *NumberExpectation[T]) Reset() {
+	e.E.Reset()
+}
+
+func (e *NumberExpectation[T]) value() T {
+	return e.E.Value.(T)
+}
+
+// check fails the test with the message if the condition is not met
+func (e *NumberExpectation[T]) check(condition bool, message string, referencedValue T) *NumberExpectation[T] {
+	if e.E.skip() {
+		return e
+	}
+	if !condition {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf(message, e.value(), referencedValue))
+	}
+	return e
+}
+
+// Equals fails test if expected is not equal to value
+func (e *NumberExpectation[T]) Equals(expected T) *NumberExpectation[T] {
+	return e.check(e.value() == expected, "Expect %v to equal %v", expected)
+}
+
+// DoesNotEqual fails test if expected is equal to value
+func (e *NumberExpectation[T]) DoesNotEqual(expected T) *NumberExpectation[T] {
+	return e.check(e.value() != expected, "Expect %v to not equal %v", expected)
+}
+
+// IsGreater fails test if value is not greater than referencedValue
+func (e *NumberExpectation[T]) IsGreater(referencedValue T) *NumberExpectation[T] {
+	return e.check(e.value() > referencedValue, "Expect %v to be greater than %v", referencedValue)
+}
+
+// IsGreaterOrEqual fails test if value is not greater than or equal to referencedValue
+func (e *NumberExpectation[T]) IsGreaterOrEqual(referencedValue T) *NumberExpectation[T] {
+	return e.check(e.value() >= referencedValue, "Expect %v to be greater than or equal to %v", referencedValue)
+}
+
+// IsLower fails test if value is not lower than referencedValue
+func (e *NumberExpectation[T]) IsLower(referencedValue T) *NumberExpectation[T] {
+	return e.check(e.value() < referencedValue, "Expect %v to be lower than %v", referencedValue)
+}
+
+// IsLowerOrEqual fails test if value is not lower than or equal to referencedValue
+func (e *NumberExpectation[T]) IsLowerOrEqual(referencedValue T) *NumberExpectation[T] {
+	return e.check(e.value() <= referencedValue, "Expect %v to be lower than or equal to %v", referencedValue)
+}
+
+// IsBetween fails test if value is lower than lowerBound or greater than upperBound
+func (e *NumberExpectation[T]) IsBetween(lowerBound, upperBound T) *NumberExpectation[T] {
+	return e.checkRange(lowerBound, upperBound, true)
+}
+
+// IsStrictlyBetween fails test if value is not greater than lowerBound and lower than upperBound
+func (e *NumberExpectation[T]) IsStrictlyBetween(lowerBound, upperBound T) *NumberExpectation[T] {
+	return e.checkRange(lowerBound, upperBound, false)
+}
+
+func (e *NumberExpectation[T]) checkRange(lowerBound, upperBound T, inclusive bool) *NumberExpectation[T] {
+	if e.E.skip() {
+		return e
+	}
+	if hasNaN(e.value(), lowerBound, upperBound) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be %v %v and %v but NaN is not comparable", e.value(), rangeExpectation(inclusive), lowerBound, upperBound))
+		return e
+	}
+	violation := rangeViolation(lowerBound, upperBound, compareOrdered(lowerBound, e.value()), compareOrdered(upperBound, e.value()), inclusive)
+	if violation != "" {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be %v %v and %v but %v", e.value(), rangeExpectation(inclusive), lowerBound, upperBound, violation))
+	}
+	return e
+}
+
+// IsPositive fails test if value is not greater than zero
+func (e *NumberExpectation[T]) IsPositive() *NumberExpectation[T] {
+	return e.checkSign(e.value() > 0, "positive")
+}
+
+// IsNegative fails test if value is not lower than zero
+func (e *NumberExpectation[T]) IsNegative() *NumberExpectation[T] {
+	return e.checkSign(e.value() < 0, "negative")
+}
+
+// IsZero fails test if value is not zero
+func (e *NumberExpectation[T]) IsZero() *NumberExpectation[T] {
+	return e.checkSign(e.value() == 0, "zero")
+}
+
+func (e *NumberExpectation[T]) checkSign(condition bool, expectation string) *NumberExpectation[T] {
+	if e.E.skip() {
+		return e
+	}
+	if !condition {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be %v", e.value(), expectation))
+	}
+	return e
+}
+
+// IsCloseTo fails test if value differs from expected by more than delta
+func (e *NumberExpectation[T]) IsCloseTo(expected T, delta T) *NumberExpectation[T] {
+	e.E.IsCloseTo(expected, float64(delta))
+	return e
+}
+
+// IsWithinPercent fails test if value differs from expected by more than percent of expected
+func (e *NumberExpectation[T]) IsWithinPercent(expected T, percent float64) *NumberExpectation[T] {
+	e.E.IsWithinPercent(expected, percent)
+	return e
+}
+
+// IsWithinULPs fails test if more than ulps floating point numbers are between value and expected
+func (e *NumberExpectation[T]) IsWithinULPs(expected T, ulps uint64) *NumberExpectation[T] {
+	e.E.IsWithinULPs(expected, ulps)
+	return e
+}
+
+// IsNaN fails test if value is not a float which is not a number
+func (e *NumberExpectation[T]) IsNaN() *NumberExpectation[T] {
+	e.E.IsNaN()
+	return e
+}
+
+// IsInf fails test if value is not a positive or negative infinite float
+func (e *NumberExpectation[T]) IsInf() *NumberExpectation[T] {
+	e.E.IsInf()
+	return e
+}
+
+func compareOrdered[T Number](expected T, actual T) uint {
+	switch {
+	case actual > expected:
+		return greater
+	case actual < expected:
+		return lower
+	default:
+		return equal
+	}
+}
+
+// ===================== Typed strings ==============================
+
+// TypedStringExpectation allows to express expectations on strings of the type S
+type TypedStringExpectation[S ~string] struct {
+	E *Expectation
+}
+
+// ThatString builds a typed Expectation for strings
+func ThatString[S ~string](aEt *Et, value S) *TypedStringExpectation[S] {
+	return &TypedStringExpectation[S]{aEt.ExpectThat(string(value))}
+}
+
+func (e *TypedStringExpectation[S]) untyped() *StringExpectation {
+	return &StringExpectation{e.E}
+}
+
+// Reset sets the failed flag to false so that further expectations can be executed
+func (e *TypedStringExpectation[S]) Reset() {
+	e.E.Reset()
+}
+
+// Equals fails test if expected is not equal to value
+func (e *TypedStringExpectation[S]) Equals(expected S) *TypedStringExpectation[S] {
+	e.untyped().Equals(string(expected))
+	return e
+}
+
+// EqualsIgnoringCase fails test if expected is not equal to value ignoring the case
+func (e *TypedStringExpectation[S]) EqualsIgnoringCase(expected S) *TypedStringExpectation[S] {
+	e.untyped().EqualsIgnoringCase(string(expected))
+	return e
+}
+
+// DoesNotEqual fails test if expected is equal to value
+func (e *TypedStringExpectation[S]) DoesNotEqual(expected S) *TypedStringExpectation[S] {
+	e.untyped().DoesNotEqual(string(expected))
+	return e
+}
+
+// StartsWith checks if value starts with prefix
+func (e *TypedStringExpectation[S]) StartsWith(prefix S) *TypedStringExpectation[S] {
+	e.untyped().StartsWith(string(prefix))
+	return e
+}
+
+// EndsWith checks if value ends with suffix
+func (e *TypedStringExpectation[S]) EndsWith(suffix S) *TypedStringExpectation[S] {
+	e.untyped().EndsWith(string(suffix))
+	return e
+}
+
+// IsBetween fails test if value is lexically lower than lowerBound or greater than upperBound
+func (e *TypedStringExpectation[S]) IsBetween(lowerBound, upperBound S) *TypedStringExpectation[S] {
+	e.untyped().IsBetween(string(lowerBound), string(upperBound))
+	return e
+}
+
+// IsStrictlyBetween fails test if value is not lexically greater than lowerBound and lower than upperBound
+func (e *TypedStringExpectation[S]) IsStrictlyBetween(lowerBound, upperBound S) *TypedStringExpectation[S] {
+	e.untyped().IsStrictlyBetween(string(lowerBound), string(upperBound))
+	return e
+}
+
+// Contains checks if value contains all expected values
+func (e *TypedStringExpectation[S]) Contains(expectedValues ...S) *TypedStringExpectation[S] {
+	e.untyped().Contains(toStrings(expectedValues)...)
+	return e
+}
+
+// DoesNotContain checks if value does not contain any of the expected values
+func (e *TypedStringExpectation[S]) DoesNotContain(expectedValues ...S) *TypedStringExpectation[S] {
+	e.untyped().DoesNotContain(toStrings(expectedValues)...)
+	return e
+}
+
+func toStrings[S ~string](values []S) []string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// ===================== Typed slices ==============================
+
+// TypedSliceExpectation allows to express expectations on slices with elements of the type T
+type TypedSliceExpectation[T any] struct {
+	E *Expectation
+}
+
+// ThatSlice builds a typed Expectation for slices
+func ThatSlice[T any](aEt *Et, value []T) *TypedSliceExpectation[T] {
+	return &TypedSliceExpectation[T]{aEt.ExpectThat(value)}
+}
+
+func (e *TypedSliceExpectation[T]) untyped() *SliceExpectation {
+	return &SliceExpectation{e.E}
+}
+
+// Reset sets the failed flag to false so that further expectations can be executed
+func (e *TypedSliceExpectation[T]) Reset() {
+	e.E.Reset()
+}
+
+// Contains checks if the slice contains all expected values. Values are compared like in Equals,
+// so T need not be comparable with ==.
+func (e *TypedSliceExpectation[T]) Contains(expectedValues ...T) *TypedSliceExpectation[T] {
+	if e.E.skip() {
+		return e
+	}
+	values, expected := toSlice(e.E.Value), toInterfaces(expectedValues)
+
+	var lackingValues []interface{}
+	for _, expectedValue := range expected {
+		if !containsDeepEqual(values, expectedValue) {
+			lackingValues = append(lackingValues, expectedValue)
+		}
+	}
+
+	if len(lackingValues) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to contain %v but was missing %v", checkTypesMatch(values, expected), e.E.Value, expected, lackingValues))
+	}
+	return e
+}
+
+// DoesNotContain checks if the slice does not contain any of the expected values, see Contains
+func (e *TypedSliceExpectation[T]) DoesNotContain(expectedValues ...T) *TypedSliceExpectation[T] {
+	if e.E.skip() {
+		return e
+	}
+	values, expected := toSlice(e.E.Value), toInterfaces(expectedValues)
+
+	var additionalValues []interface{}
+	for _, expectedValue := range expected {
+		if containsDeepEqual(values, expectedValue) {
+			additionalValues = append(additionalValues, expectedValue)
+		}
+	}
+
+	if len(additionalValues) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to not contain %v but it includes %v", checkTypesMatch(values, expected), e.E.Value, expected, additionalValues))
+	}
+	return e
+}
+
+// IsEmpty fails test if the slice has elements
+func (e *TypedSliceExpectation[T]) IsEmpty() *TypedSliceExpectation[T] {
+	e.untyped().IsEmpty()
+	return e
+}
+
+// IsNotEmpty fails test if the slice has no elements
+func (e *TypedSliceExpectation[T]) IsNotEmpty() *TypedSliceExpectation[T] {
+	e.untyped().IsNotEmpty()
+	return e
+}
+
+// HasSize fails test if the slice has not the expected number of elements
+func (e *TypedSliceExpectation[T]) HasSize(expectedValue uint) *TypedSliceExpectation[T] {
+	e.untyped().HasSize(expectedValue)
+	return e
+}
+
+func (e *TypedSliceExpectation[T]) First() *TypedExpectation[T] {
+	return e.Nth(0)
+}
+
+func (e *TypedSliceExpectation[T]) Second() *TypedExpectation[T] {
+	return e.Nth(1)
+}
+
+func (e *TypedSliceExpectation[T]) Third() *TypedExpectation[T] {
+	return e.Nth(2)
+}
+
+// Nth builds a typed Expectation for the element at the index nthElement
+func (e *TypedSliceExpectation[T]) Nth(nthElement int) *TypedExpectation[T] {
+	return &TypedExpectation[T]{e.untyped().Nth(nthElement)}
+}
+
+func toInterfaces[T any](values []T) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
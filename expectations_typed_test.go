@@ -0,0 +1,103 @@
+package expectations_test
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type celsius float64
+
+type name string
+
+func TestTypedDemo(t *testing.T) {
+	eT := expectations.NewT(t)
+
+	expectations.That(&eT, []int{1, 2}).Equals([]int{1, 2}).IsNotNil()
+	expectations.ThatNumber(&eT, uint(5)).Equals(5).IsGreater(4).IsLowerOrEqual(5)
+	expectations.ThatNumber(&eT, celsius(21.5)).IsGreaterOrEqual(21.5).IsLower(30)
+	expectations.ThatString(&eT, name("Hello World")).StartsWith("Hello").Contains("o W").EqualsIgnoringCase("hello world")
+	expectations.ThatSlice(&eT, []string{"a", "b"}).HasSize(2).Contains("b").DoesNotContain("c")
+	expectations.ThatSlice(&eT, []string{"a", "b"}).Second().Equals("b")
+}
+
+type TypedNumberTestCase struct {
+	Fn            func(uint) *expectations.NumberExpectation[uint]
+	ExpectedValue uint
+	Succeeds      bool
+}
+
+func TestTypedNumberExpectations(t *testing.T) {
+	tMock := &TMock{}
+	eT := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+	var actualValue uint = 2
+	expect := expectations.ThatNumber(&eT, actualValue)
+
+	testCases := []TypedNumberTestCase{
+		TypedNumberTestCase{expect.Equals, 2, true},
+		TypedNumberTestCase{expect.Equals, 1, false},
+		TypedNumberTestCase{expect.DoesNotEqual, 1, true},
+		TypedNumberTestCase{expect.DoesNotEqual, 2, false},
+		TypedNumberTestCase{expect.IsGreater, 1, true},
+		TypedNumberTestCase{expect.IsGreater, 2, false},
+		TypedNumberTestCase{expect.IsGreaterOrEqual, 2, true},
+		TypedNumberTestCase{expect.IsGreaterOrEqual, 3, false},
+		TypedNumberTestCase{expect.IsLower, 3, true},
+		TypedNumberTestCase{expect.IsLower, 2, false},
+		TypedNumberTestCase{expect.IsLowerOrEqual, 2, true},
+		TypedNumberTestCase{expect.IsLowerOrEqual, 1, false},
+	}
+
+	for _, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(testCase.ExpectedValue)
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test failed: %v %v %v should be %v", actualValue, functionName(testCase.Fn), testCase.ExpectedValue, testCase.Succeeds)
+		}
+		expect.Reset()
+	}
+}
+
+func TestTypedExpectationsReportLineOfTest(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	eT := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	_, _, line, _ := runtime.Caller(0)
+	expectations.ThatSlice(&eT, []int{1}).Contains(2)
+	if !strings.Contains(loggerMock.logs, fmt.Sprintf("TestTypedExpectationsReportLineOfTest in line %v", line+1)) {
+		t.Errorf("Expected '%v' to show the line of the test", loggerMock.logs)
+	}
+}
+
+func TestTypedSliceStopsOnMissingElement(t *testing.T) {
+	tMock := &TMock{}
+	eT := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	expectations.ThatSlice(&eT, []int{1}).Third().Equals(1)
+	if !tMock.HasBeenCalled {
+		t.Error("Third should fail on a slice with one element")
+	}
+}
+
+func TestTypedSliceContainsUncomparableElements(t *testing.T) {
+	tMock := &TMock{}
+	eT := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	expectations.ThatSlice(&eT, [][]int{{1}, {2, 3}}).Contains([]int{2, 3}).DoesNotContain([]int{1, 2})
+	if tMock.HasBeenCalled {
+		t.Error("Expect slices to be compared by their elements")
+	}
+	expectations.ThatSlice(&eT, [][]int{{1}}).Contains([]int{2})
+	if !tMock.HasBeenCalled {
+		t.Error("Expect a missing slice to fail")
+	}
+	tMock.reset()
+	expectations.ThatSlice(&eT, []map[string]int{{"a": 1}}).DoesNotContain(map[string]int{"a": 1})
+	if !tMock.HasBeenCalled {
+		t.Error("Expect a contained map to fail")
+	}
+}
@@ -0,0 +1,252 @@
+package expectations
+
+import (
+	"fmt"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ===================== Unicode ==============================
+
+// EqualsFolded fails test if expected is not equal to value using Unicode full case folding.
+// In contrast to EqualsIgnoringCase "ß" equals "SS" and "ﬁ" equals "FI". Dotted and dotless i are folded
+// like in most languages, not with the special rules of Turkish and Azeri.
+func (e *StringExpectation) EqualsFolded(expected interface{}) *StringExpectation {
+	return e.equalsNormalized(expected, "with case folding", foldCase)
+}
+
+// EqualsNFC fails test if expected is not canonically equivalent to value, both are compared in the
+// normalization form C (canonical composition)
+func (e *StringExpectation) EqualsNFC(expected interface{}) *StringExpectation {
+	return e.equalsNormalized(expected, "in normalization form C", toNFC)
+}
+
+// EqualsNFD fails test if expected is not canonically equivalent to value, both are compared in the
+// normalization form D (canonical decomposition)
+func (e *StringExpectation) EqualsNFD(expected interface{}) *StringExpectation {
+	return e.equalsNormalized(expected, "in normalization form D", toNFD)
+}
+
+// HasRuneCount fails test if value does not consist of expectedCount runes
+func (e *StringExpectation) HasRuneCount(expectedCount int) *StringExpectation {
+	if e.E.skip() {
+		return e
+	}
+	valueString, valueOk := e.E.Value.(string)
+	if !valueOk {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to be a string", showTypeInfos, e.E.Value))
+	} else if count := utf8.RuneCountInString(valueString); count != expectedCount {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to have %v runes and not %v", valueString, expectedCount, count))
+	}
+	return e
+}
+
+// HasByteLength fails test if value does not consist of expectedLength bytes
+func (e *StringExpectation) HasByteLength(expectedLength int) *StringExpectation {
+	if e.E.skip() {
+		return e
+	}
+	valueString, valueOk := e.E.Value.(string)
+	if !valueOk {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to be a string", showTypeInfos, e.E.Value))
+	} else if len(valueString) != expectedLength {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to have %v bytes and not %v", valueString, expectedLength, len(valueString)))
+	}
+	return e
+}
+
+// IsValidUTF8 fails test if value contains bytes which are not valid UTF-8
+func (e *StringExpectation) IsValidUTF8() *StringExpectation {
+	if e.E.skip() {
+		return e
+	}
+	valueString, valueOk := e.E.Value.(string)
+	if !valueOk {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to be a string", showTypeInfos, e.E.Value))
+		return e
+	}
+	for offset, r := range valueString {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(valueString[offset:]); size == 1 {
+				e.E.failed = true
+				fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to be valid UTF-8 but byte %v is 0x%02X", valueString, offset, valueString[offset]))
+				return e
+			}
+		}
+	}
+	return e
+}
+
+// ===================== Case folding ==============================
+
+// fullCaseFoldings lists the runes whose case folding results in multiple runes.
+// The Greek letters with iota subscript are folded by simpleFold only.
+var fullCaseFoldings = map[rune]string{
+	0x00DF: "ss", 0x0130: "i\u0307", 0x0149: "\u02bcn", 0x01F0: "j\u030c",
+	0x0390: "\u03b9\u0308\u0301", 0x03B0: "\u03c5\u0308\u0301", 0x0587: "\u0565\u0582",
+	0x1E96: "h\u0331", 0x1E97: "t\u0308", 0x1E98: "w\u030a", 0x1E99: "y\u030a", 0x1E9A: "a\u02be", 0x1E9E: "ss",
+	0xFB00: "ff", 0xFB01: "fi", 0xFB02: "fl", 0xFB03: "ffi", 0xFB04: "ffl", 0xFB05: "st", 0xFB06: "st",
+	0xFB13: "\u0574\u0576", 0xFB14: "\u0574\u0565", 0xFB15: "\u0574\u056b", 0xFB16: "\u057e\u0576", 0xFB17: "\u0574\u056d",
+}
+
+// foldCase maps every rune to a representative of all runes which are equal ignoring the case
+func foldCase(s string) string {
+	result := make([]rune, 0, len(s))
+	for _, r := range s {
+		if folding, ok := fullCaseFoldings[r]; ok {
+			for _, folded := range folding {
+				result = append(result, simpleFold(folded))
+			}
+		} else {
+			result = append(result, simpleFold(r))
+		}
+	}
+	return string(result)
+}
+
+// simpleFold returns the smallest rune of the case folding orbit of r, like strings.EqualFold does
+func simpleFold(r rune) rune {
+	smallest := r
+	for folded := unicode.SimpleFold(r); folded != r; folded = unicode.SimpleFold(folded) {
+		if folded < smallest {
+			smallest = folded
+		}
+	}
+	return smallest
+}
+
+// ===================== Normalization ==============================
+
+type combiningClassRange struct {
+	first, last rune
+	class       uint8
+}
+
+const (
+	hangulSyllableBase = 0xAC00
+	hangulLeadingBase  = 0x1100
+	hangulVowelBase    = 0x1161
+	hangulTrailingBase = 0x11A7
+	hangulLeadingCount = 19
+	hangulVowelCount   = 21
+	hangulTrailCount   = 28
+	hangulSyllableEnd  = hangulSyllableBase + hangulLeadingCount*hangulVowelCount*hangulTrailCount
+)
+
+// canonicalCompositions maps a pair of a starter and a following rune to their primary composite
+var canonicalCompositions = func() map[[2]rune]rune {
+	excluded := make(map[rune]bool)
+	for _, r := range compositionExclusions {
+		excluded[r] = true
+	}
+	composites := make(map[string]rune)
+	for r, decomposition := range canonicalDecompositions {
+		if utf8.RuneCountInString(decomposition) > 1 && !excluded[r] {
+			composites[decomposition] = r
+		}
+	}
+
+	result := make(map[[2]rune]rune)
+	for decomposition, r := range composites {
+		runes := []rune(decomposition)
+		last := runes[len(runes)-1]
+		if len(runes) == 2 {
+			result[[2]rune{runes[0], last}] = r
+		} else if starter, ok := composites[string(runes[:len(runes)-1])]; ok {
+			result[[2]rune{starter, last}] = r
+		}
+	}
+	return result
+}()
+
+func combiningClass(r rune) uint8 {
+	i := sort.Search(len(combiningClasses), func(i int) bool { return combiningClasses[i].last >= r })
+	if i < len(combiningClasses) && combiningClasses[i].first <= r {
+		return combiningClasses[i].class
+	}
+	return 0
+}
+
+// toNFD decomposes all runes and sorts combining marks by their combining class
+func toNFD(s string) string {
+	return string(decomposeRunes(s))
+}
+
+// toNFC decomposes all runes and composes them again to primary composites
+func toNFC(s string) string {
+	return string(composeRunes(decomposeRunes(s)))
+}
+
+func decomposeRunes(s string) []rune {
+	result := make([]rune, 0, len(s))
+	for _, r := range s {
+		if r >= hangulSyllableBase && r < hangulSyllableEnd {
+			index := r - hangulSyllableBase
+			result = append(result, hangulLeadingBase+index/(hangulVowelCount*hangulTrailCount), hangulVowelBase+(index%(hangulVowelCount*hangulTrailCount))/hangulTrailCount)
+			if trailing := index % hangulTrailCount; trailing != 0 {
+				result = append(result, hangulTrailingBase+trailing)
+			}
+		} else if decomposition, ok := canonicalDecompositions[r]; ok {
+			result = append(result, []rune(decomposition)...)
+		} else {
+			result = append(result, r)
+		}
+	}
+
+	// canonical ordering: sort each run of combining marks by combining class
+	for start := 0; start < len(result); start++ {
+		if combiningClass(result[start]) == 0 {
+			continue
+		}
+		end := start
+		for end < len(result) && combiningClass(result[end]) != 0 {
+			end++
+		}
+		marks := result[start:end]
+		sort.SliceStable(marks, func(i, j int) bool { return combiningClass(marks[i]) < combiningClass(marks[j]) })
+		start = end
+	}
+	return result
+}
+
+func composeRunes(runes []rune) []rune {
+	result := make([]rune, 0, len(runes))
+	starter := -1
+	lastClass := -1 // combining class of the last rune after the starter, -1 if the starter is the last rune
+	for _, r := range runes {
+		class := int(combiningClass(r))
+		if starter >= 0 && lastClass < class {
+			if composite, ok := compose(result[starter], r); ok {
+				result[starter] = composite
+				continue
+			}
+		}
+		if class == 0 {
+			starter = len(result)
+			lastClass = -1
+		} else {
+			lastClass = class
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+func compose(starter, r rune) (rune, bool) {
+	if starter >= hangulLeadingBase && starter < hangulLeadingBase+hangulLeadingCount &&
+		r >= hangulVowelBase && r < hangulVowelBase+hangulVowelCount {
+		return hangulSyllableBase + ((starter-hangulLeadingBase)*hangulVowelCount+r-hangulVowelBase)*hangulTrailCount, true
+	}
+	if starter >= hangulSyllableBase && starter < hangulSyllableEnd && (starter-hangulSyllableBase)%hangulTrailCount == 0 &&
+		r > hangulTrailingBase && r < hangulTrailingBase+hangulTrailCount {
+		return starter + r - hangulTrailingBase, true
+	}
+	composite, ok := canonicalCompositions[[2]rune{starter, r}]
+	return composite, ok
+}
@@ -0,0 +1,66 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type UnicodeTestCase struct {
+	Fn       func(*expectations.StringExpectation)
+	Value    string
+	Succeeds bool
+}
+
+func TestUnicodeExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	testCases := []UnicodeTestCase{
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsFolded("STRASSE") }, "straße", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsFolded("Straße") }, "STRASSE", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsFolded("ΣΊΣΥΦΟΣ") }, "σίσυφος", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsFolded("FILE") }, "ﬁle", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsFolded("istanbul") }, "İstanbul", false},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsFolded("i̇stanbul") }, "İstanbul", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsFolded("ısparta") }, "Isparta", false},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsFolded("strasse") }, "strase", false},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsNFC("Café") }, "Café", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsNFC("Cafe") }, "Café", false},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsNFD("ṩ") }, "ṩ", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsNFD("한글") }, "한글", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.EqualsNFC("Å") }, "Å", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.HasRuneCount(4) }, "Café", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.HasRuneCount(5) }, "Café", false},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.HasByteLength(5) }, "Café", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.HasByteLength(4) }, "Café", false},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.IsValidUTF8() }, "Café", true},
+		UnicodeTestCase{func(e *expectations.StringExpectation) { e.IsValidUTF8() }, "Caf\xe9", false},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(et.ExpectThatString(testCase.Value))
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed: %+q should be %v", i, testCase.Value, testCase.Succeeds)
+		}
+	}
+}
+
+func TestUnicodeShowsNormalizedDifference(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatString("Cafè").EqualsNFC("Café")
+	if !strings.Contains(loggerMock.logs, "first difference at rune 3: expected 'é' (U+00E9), actual 'è' (U+00E8)") {
+		t.Errorf("Expected '%v' to show the difference of the normalized strings", loggerMock.logs)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatString("Caf\xe9").IsValidUTF8()
+	if !strings.Contains(loggerMock.logs, "to be valid UTF-8 but byte 3 is 0xE9") {
+		t.Errorf("Expected '%v' to show the invalid byte", loggerMock.logs)
+	}
+}
@@ -0,0 +1,82 @@
+package expectations
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ===================== Whitespace insensitive strings ==============================
+
+// EqualsIgnoringWhitespace fails test if expected is not equal to value after removing all whitespace
+func (e *StringExpectation) EqualsIgnoringWhitespace(expected interface{}) *StringExpectation {
+	return e.equalsNormalized(expected, "ignoring whitespace", removeWhitespace)
+}
+
+// EqualsNormalizingWhitespace fails test if expected is not equal to value after replacing each run of whitespace
+// with a single space and trimming leading and trailing whitespace
+func (e *StringExpectation) EqualsNormalizingWhitespace(expected interface{}) *StringExpectation {
+	return e.equalsNormalized(expected, "normalizing whitespace", normalizeWhitespace)
+}
+
+// EqualsIgnoringLineEndings fails test if expected is not equal to value after converting \r\n and \r to \n
+func (e *StringExpectation) EqualsIgnoringLineEndings(expected interface{}) *StringExpectation {
+	return e.equalsNormalized(expected, "ignoring line endings", normalizeLineEndings)
+}
+
+// EqualsIgnoringIndentation fails test if expected is not equal to value after removing leading whitespace of each line
+func (e *StringExpectation) EqualsIgnoringIndentation(expected interface{}) *StringExpectation {
+	return e.equalsNormalized(expected, "ignoring indentation", removeIndentation)
+}
+
+// equalsNormalized compares value and expected after applying normalize to both of them.
+// The fail message shows the difference of the normalized strings.
+func (e *StringExpectation) equalsNormalized(expected interface{}, description string, normalize func(string) string) *StringExpectation {
+	if e.E.skip() {
+		return e
+	}
+	valueString, valueOk := e.E.Value.(string)
+	expectedString, expectedOk := expected.(string)
+	if !(valueOk && expectedOk) {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to equal %v "+description, showTypeInfos, e.E.Value, expected))
+		return e
+	}
+
+	normalizedValue := normalize(valueString)
+	normalizedExpected := normalize(expectedString)
+	if normalizedValue == normalizedExpected {
+		return e
+	}
+	e.E.failed = true
+	if isMultiLine(normalizedValue, normalizedExpected) {
+		fail(e.E.T, e.E.Logger, buildMultiLineFailMessage("Expect multi-line string to equal the expected value "+description+":", normalizedExpected, normalizedValue, e.E.diffContextLines()))
+	} else {
+		fail(e.E.T, e.E.Logger, buildFailMessage("Expect %v to equal %v "+description+"\n", false, e.E.Value, expected)+buildCharacterDiff(normalizedExpected, normalizedValue, equalRune))
+	}
+	return e
+}
+
+func removeWhitespace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func normalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func normalizeLineEndings(s string) string {
+	return strings.Replace(strings.Replace(s, "\r\n", "\n", -1), "\r", "\n", -1)
+}
+
+func removeIndentation(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimLeftFunc(line, unicode.IsSpace)
+	}
+	return strings.Join(lines, "\n")
+}
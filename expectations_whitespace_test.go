@@ -0,0 +1,76 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+type WhitespaceTestCase struct {
+	Fn       func(interface{}) *expectations.StringExpectation
+	Expected interface{}
+	Succeeds bool
+}
+
+func TestWhitespaceExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	expect := func(actual string, method string) func(interface{}) *expectations.StringExpectation {
+		e := et.ExpectThatString(actual)
+		return map[string]func(interface{}) *expectations.StringExpectation{
+			"ignoring":    e.EqualsIgnoringWhitespace,
+			"normalizing": e.EqualsNormalizingWhitespace,
+			"lineEndings": e.EqualsIgnoringLineEndings,
+			"indentation": e.EqualsIgnoringIndentation,
+		}[method]
+	}
+
+	testCases := []WhitespaceTestCase{
+		WhitespaceTestCase{expect("SELECT *\n  FROM t", "ignoring"), "SELECT * FROM t", true},
+		WhitespaceTestCase{expect("SELECT *\n  FROM u", "ignoring"), "SELECT * FROM t", false},
+		WhitespaceTestCase{expect("a", "ignoring"), nil, false},
+		WhitespaceTestCase{expect("  SELECT *\n\tFROM t ", "normalizing"), "SELECT * FROM t", true},
+		WhitespaceTestCase{expect("SELECT*FROM t", "normalizing"), "SELECT * FROM t", false},
+		WhitespaceTestCase{expect("a\r\nb\rc", "lineEndings"), "a\nb\nc", true},
+		WhitespaceTestCase{expect("a\r\nb", "lineEndings"), "a b", false},
+		WhitespaceTestCase{expect("a:\n    b: 1\r\n\tc: 2", "indentation"), "a:\nb: 1\r\n  c: 2", true},
+		WhitespaceTestCase{expect("a:\n  b: 1 ", "indentation"), "a:\nb: 1", false},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(testCase.Expected)
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed: %v %v should be %v", i, functionName(testCase.Fn), testCase.Expected, testCase.Succeeds)
+		}
+	}
+}
+
+func TestWhitespaceShowsDiffOfNormalizedStrings(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatString("a\r\nb\r\nc").EqualsIgnoringLineEndings("a\nx\nc")
+	expectedDiff := strings.Join([]string{
+		"Expect multi-line string to equal the expected value ignoring line endings:",
+		"\t--- expected",
+		"\t+++ actual",
+		"\t@@ -1,3 +1,3 @@",
+		"\t1 1   a",
+		"\t2   - x",
+		"\t  2 + b",
+		"\t3 3   c",
+	}, "\n")
+	if !strings.Contains(loggerMock.logs, expectedDiff) {
+		t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expectedDiff)
+	}
+
+	loggerMock.Reset()
+	et.ExpectThatString("SELECT  *  FROM t").EqualsNormalizingWhitespace("SELECT * FROM u")
+	if !strings.Contains(loggerMock.logs, "first difference at rune 14: expected 'u' (U+0075), actual 't' (U+0074)") {
+		t.Errorf("Expected '%v' to show the difference of the normalized strings", loggerMock.logs)
+	}
+}
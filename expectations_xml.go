@@ -0,0 +1,476 @@
+package expectations
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ===================== XML ==============================
+
+// XMLExpectation allows to express expectations on XML documents
+type XMLExpectation struct {
+	E *Expectation
+}
+
+// ExpectThatXML builds an Expectation for a XML document given as string or []byte
+func (aEt *Et) ExpectThatXML(document interface{}) *XMLExpectation {
+	return &XMLExpectation{aEt.ExpectThat(document)}
+}
+
+// Reset sets the failed flag to false, so that further checks can be executed
+func (e *XMLExpectation) Reset() {
+	e.E.failed = false
+}
+
+// document parses the value and fails the test if it is not valid XML
+func (e *XMLExpectation) document() (*xmlNode, bool) {
+	root, err := parseXML(e.E.Value)
+	if err != nil {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %v to be valid XML: %v", formatDocumentSource(e.E.Value), err))
+		return nil, false
+	}
+	return root, true
+}
+
+// EqualsXML checks if the document is equal to expected. The order of attributes, whitespace around texts,
+// comments and the prefixes used for namespaces are ignored.
+func (e *XMLExpectation) EqualsXML(expected interface{}) *XMLExpectation {
+	if e.E.skip() {
+		return e
+	}
+	root, ok := e.document()
+	if !ok {
+		return e
+	}
+	expectedRoot, err := parseXML(expected)
+	if err != nil {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect the expected value %v to be valid XML: %v", formatDocumentSource(expected), err))
+		return e
+	}
+
+	var differences []difference
+	compareXML(&differences, "/"+expectedRoot.name.Local, expectedRoot, root)
+	if len(differences) > 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect XML to equal the expected XML, found %v difference(s):\n%v", len(differences), formatDifferences(differences, describeXMLDifference)))
+	}
+	return e
+}
+
+// HasElement fails test if no element matches path, see ElementCount for the supported paths
+func (e *XMLExpectation) HasElement(path string) *XMLExpectation {
+	if e.E.skip() {
+		return e
+	}
+	if elements, ok := e.selectElements(path); ok && len(elements) == 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect XML to contain element %v", path))
+	}
+	return e
+}
+
+// ElementCount builds an Expectation for the number of elements matching path.
+// Paths are a subset of XPath: /a/b selects children, //b descendants and * any element.
+// A step may be followed by predicates like [2] or [@id='7']. Elements are matched by their local name.
+func (e *XMLExpectation) ElementCount(path string) *Expectation {
+	if e.E.skip() {
+		return e.E
+	}
+	elements, ok := e.selectElements(path)
+	if !ok {
+		return e.E.abort()
+	}
+	return e.E.child(len(elements))
+}
+
+// Attribute builds an Expectation for the attribute name of the first element matching path
+func (e *XMLExpectation) Attribute(path, name string) *StringExpectation {
+	if e.E.skip() {
+		return &StringExpectation{e.E}
+	}
+	elements, ok := e.selectElements(path)
+	if !ok {
+		return &StringExpectation{e.E.abort()}
+	}
+	if len(elements) == 0 {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect XML to contain element %v", path))
+		return &StringExpectation{e.E.abort()}
+	}
+	for _, attribute := range elements[0].attributes {
+		if attribute.Name.Local == name {
+			return &StringExpectation{e.E.child(attribute.Value)}
+		}
+	}
+	e.E.failed = true
+	fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect element %v to have attribute %v", path, name))
+	return &StringExpectation{e.E.abort()}
+}
+
+// selectElements returns the elements matching path and fails the test if the path or the document is invalid
+func (e *XMLExpectation) selectElements(path string) ([]*xmlNode, bool) {
+	steps, err := parseXMLPath(path)
+	if err != nil {
+		e.E.failed = true
+		fail(e.E.T, e.E.Logger, fmt.Sprintf("Expect %q to be a valid XML path: %v", path, err))
+		return nil, false
+	}
+	root, ok := e.document()
+	if !ok {
+		return nil, false
+	}
+	return selectXML(root, steps), true
+}
+
+// xmlNode is either an element or a text
+type xmlNode struct {
+	name       xml.Name
+	attributes []xml.Attr
+	children   []*xmlNode
+	text       string
+	isText     bool
+}
+
+func (n *xmlNode) String() string {
+	if n.isText {
+		return strconv.Quote(n.text)
+	}
+	return "<" + formatXMLName(n.name) + ">"
+}
+
+func formatXMLName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return "{" + name.Space + "}" + name.Local
+}
+
+// parseXML builds the tree of a document given as string or []byte. Comments, processing instructions and
+// namespace declarations are dropped, texts are trimmed and attributes are sorted.
+func parseXML(source interface{}) (*xmlNode, error) {
+	var data []byte
+	switch source := source.(type) {
+	case string:
+		data = []byte(source)
+	case []byte:
+		data = source
+	default:
+		return nil, fmt.Errorf("expected a string or []byte but got %T", source)
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var root *xmlNode
+	var open []*xmlNode
+	var text strings.Builder
+	flushText := func() {
+		if trimmed := strings.TrimSpace(text.String()); trimmed != "" && len(open) > 0 {
+			parent := open[len(open)-1]
+			parent.children = append(parent.children, &xmlNode{text: trimmed, isText: true})
+		}
+		text.Reset()
+	}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			flushText()
+			if root != nil && len(open) == 0 {
+				return nil, fmt.Errorf("more than one root element")
+			}
+			element := &xmlNode{name: token.Name}
+			for _, attribute := range token.Attr {
+				if attribute.Name.Space != "xmlns" && !(attribute.Name.Space == "" && attribute.Name.Local == "xmlns") {
+					element.attributes = append(element.attributes, attribute)
+				}
+			}
+			sort.Slice(element.attributes, func(i, j int) bool {
+				return formatXMLName(element.attributes[i].Name) < formatXMLName(element.attributes[j].Name)
+			})
+			if len(open) > 0 {
+				parent := open[len(open)-1]
+				parent.children = append(parent.children, element)
+			} else {
+				root = element
+			}
+			open = append(open, element)
+		case xml.EndElement:
+			flushText()
+			open = open[:len(open)-1]
+		case xml.CharData:
+			text.Write(token)
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("no root element")
+	}
+	return root, nil
+}
+
+// compareXML collects the differences of two elements and their children
+func compareXML(differences *[]difference, path string, expected, actual *xmlNode) {
+	if expected.isText != actual.isText || expected.text != actual.text || expected.name != actual.name {
+		*differences = append(*differences, difference{path, changed, reflect.ValueOf(expected.String()), reflect.ValueOf(actual.String())})
+		return
+	}
+
+	for _, name := range sortedAttributeNames(expected.attributes, actual.attributes) {
+		attributePath := path + "/@" + name.Local
+		expectedValue, inExpected := findAttribute(expected.attributes, name)
+		actualValue, inActual := findAttribute(actual.attributes, name)
+		switch {
+		case !inActual:
+			*differences = append(*differences, difference{attributePath, removed, reflect.ValueOf(strconv.Quote(expectedValue)), reflect.Value{}})
+		case !inExpected:
+			*differences = append(*differences, difference{attributePath, added, reflect.Value{}, reflect.ValueOf(strconv.Quote(actualValue))})
+		case expectedValue != actualValue:
+			*differences = append(*differences, difference{attributePath, changed, reflect.ValueOf(strconv.Quote(expectedValue)), reflect.ValueOf(strconv.Quote(actualValue))})
+		}
+	}
+
+	expectedPaths := childPaths(path, expected.children)
+	actualPaths := childPaths(path, actual.children)
+	for i := 0; i < len(expected.children) || i < len(actual.children); i++ {
+		switch {
+		case i >= len(actual.children):
+			*differences = append(*differences, difference{expectedPaths[i], removed, reflect.ValueOf(expected.children[i].String()), reflect.Value{}})
+		case i >= len(expected.children):
+			*differences = append(*differences, difference{actualPaths[i], added, reflect.Value{}, reflect.ValueOf(actual.children[i].String())})
+		default:
+			compareXML(differences, expectedPaths[i], expected.children[i], actual.children[i])
+		}
+	}
+}
+
+// childPaths returns a XPath for every child. Positions are added if siblings share the same name.
+func childPaths(path string, children []*xmlNode) []string {
+	step := func(child *xmlNode) string {
+		if child.isText {
+			return "text()"
+		}
+		return child.name.Local
+	}
+	counts := map[string]int{}
+	for _, child := range children {
+		counts[step(child)]++
+	}
+	positions := map[string]int{}
+	var paths []string
+	for _, child := range children {
+		name := step(child)
+		positions[name]++
+		if counts[name] > 1 {
+			name += fmt.Sprintf("[%v]", positions[name])
+		}
+		paths = append(paths, path+"/"+name)
+	}
+	return paths
+}
+
+func sortedAttributeNames(attributeLists ...[]xml.Attr) []xml.Name {
+	var names []xml.Name
+	seen := map[xml.Name]bool{}
+	for _, attributes := range attributeLists {
+		for _, attribute := range attributes {
+			if !seen[attribute.Name] {
+				seen[attribute.Name] = true
+				names = append(names, attribute.Name)
+			}
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return formatXMLName(names[i]) < formatXMLName(names[j])
+	})
+	return names
+}
+
+func findAttribute(attributes []xml.Attr, name xml.Name) (string, bool) {
+	for _, attribute := range attributes {
+		if attribute.Name == name {
+			return attribute.Value, true
+		}
+	}
+	return "", false
+}
+
+func describeXMLDifference(d difference) string {
+	switch d.kind {
+	case added:
+		return fmt.Sprintf("%v: unexpected %v", d.path, d.actual)
+	case removed:
+		return fmt.Sprintf("%v: missing, want %v", d.path, d.expected)
+	}
+	return fmt.Sprintf("%v: want %v, got %v", d.path, d.expected, d.actual)
+}
+
+// xmlPathStep selects children or descendants by name and filters them with predicates
+type xmlPathStep struct {
+	descendants bool
+	name        string
+	predicates  []xmlPredicate
+}
+
+// xmlPredicate either selects a position, starting with 1, or requires an attribute value
+type xmlPredicate struct {
+	position  int
+	attribute string
+	value     string
+}
+
+func (p xmlPredicate) matches(element *xmlNode) bool {
+	for _, attribute := range element.attributes {
+		if attribute.Name.Local == p.attribute {
+			return attribute.Value == p.value
+		}
+	}
+	return false
+}
+
+// parseXMLPath parses paths like /feed//entry[2]/link[@rel='self']
+func parseXMLPath(path string) ([]xmlPathStep, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path must start with /")
+	}
+	var steps []xmlPathStep
+	for i := 0; i < len(path); {
+		step := xmlPathStep{}
+		if strings.HasPrefix(path[i:], "//") {
+			step.descendants = true
+			i += 2
+		} else if path[i] == '/' {
+			i++
+		} else {
+			return nil, fmt.Errorf("unexpected %q at offset %v", path[i], i)
+		}
+
+		end := i
+		for end < len(path) && path[end] != '/' && path[end] != '[' {
+			end++
+		}
+		if end == i {
+			return nil, fmt.Errorf("missing element name at offset %v", i)
+		}
+		step.name = path[i:end]
+		if colon := strings.LastIndexByte(step.name, ':'); colon >= 0 {
+			step.name = step.name[colon+1:]
+		}
+		i = end
+
+		for i < len(path) && path[i] == '[' {
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("missing ] at offset %v", len(path))
+			}
+			predicate, err := parseXMLPredicate(path[i+1 : i+end])
+			if err != nil {
+				return nil, err
+			}
+			step.predicates = append(step.predicates, predicate)
+			i += end + 1
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func parseXMLPredicate(predicate string) (xmlPredicate, error) {
+	if position, err := strconv.Atoi(predicate); err == nil && position > 0 {
+		return xmlPredicate{position: position}, nil
+	}
+	if strings.HasPrefix(predicate, "@") {
+		if parts := strings.SplitN(predicate[1:], "=", 2); len(parts) == 2 {
+			value := strings.TrimSpace(parts[1])
+			if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+				return xmlPredicate{attribute: strings.TrimSpace(parts[0]), value: value[1 : len(value)-1]}, nil
+			}
+		}
+	}
+	return xmlPredicate{}, fmt.Errorf("unsupported predicate [%v], use a position like [2] or an attribute like [@id='7']", predicate)
+}
+
+// selectXML returns the elements matching steps in document order. Like in XPath, positions
+// count the matching children of each parent, also for descendants.
+func selectXML(root *xmlNode, steps []xmlPathStep) []*xmlNode {
+	documentOrder := map[*xmlNode]int{}
+	for i, element := range descendantElements(&xmlNode{children: []*xmlNode{root}}) {
+		documentOrder[element] = i
+	}
+
+	context := []*xmlNode{&xmlNode{children: []*xmlNode{root}}}
+	for _, step := range steps {
+		var parents []*xmlNode
+		for _, node := range context {
+			parents = append(parents, node)
+			if step.descendants {
+				parents = append(parents, descendantElements(node)...)
+			}
+		}
+
+		var selected []*xmlNode
+		seen := map[*xmlNode]bool{}
+		for _, parent := range parents {
+			var candidates []*xmlNode
+			for _, child := range parent.children {
+				if !child.isText && (step.name == "*" || child.name.Local == step.name) {
+					candidates = append(candidates, child)
+				}
+			}
+			for _, candidate := range applyXMLPredicates(candidates, step.predicates) {
+				if !seen[candidate] {
+					seen[candidate] = true
+					selected = append(selected, candidate)
+				}
+			}
+		}
+		sort.Slice(selected, func(i, j int) bool {
+			return documentOrder[selected[i]] < documentOrder[selected[j]]
+		})
+		context = selected
+	}
+	return context
+}
+
+// descendantElements returns all elements below node in document order
+func descendantElements(node *xmlNode) []*xmlNode {
+	var elements []*xmlNode
+	for _, child := range node.children {
+		if !child.isText {
+			elements = append(elements, child)
+			elements = append(elements, descendantElements(child)...)
+		}
+	}
+	return elements
+}
+
+func applyXMLPredicates(elements []*xmlNode, predicates []xmlPredicate) []*xmlNode {
+	for _, predicate := range predicates {
+		if predicate.position > 0 {
+			if predicate.position > len(elements) {
+				return nil
+			}
+			elements = elements[predicate.position-1 : predicate.position]
+			continue
+		}
+		var filtered []*xmlNode
+		for _, element := range elements {
+			if predicate.matches(element) {
+				filtered = append(filtered, element)
+			}
+		}
+		elements = filtered
+	}
+	return elements
+}
@@ -0,0 +1,110 @@
+package expectations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/laliluna/expectations"
+)
+
+const feed = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+	<!-- generated -->
+	<title>News</title>
+	<entry id="1"><title>First</title><link rel="self" href="/1"/></entry>
+	<entry id="2">
+		<title>Second</title>
+		<link rel="alternate" href="/2.html"/>
+		<link rel="self" href="/2"/>
+	</entry>
+</feed>`
+
+type XMLTestCase struct {
+	Fn       func(*expectations.XMLExpectation)
+	Succeeds bool
+}
+
+func TestXMLExpectations(t *testing.T) {
+	tMock := &TMock{}
+	et := expectations.NewTWithLogger(tMock, &SilenceLoggerMock{})
+
+	testCases := []XMLTestCase{
+		XMLTestCase{func(e *expectations.XMLExpectation) {
+			e.EqualsXML(`<a:feed xmlns:a="http://www.w3.org/2005/Atom"><a:title> News </a:title>
+				<a:entry id="1"><a:title>First</a:title><a:link href="/1" rel="self"></a:link></a:entry>
+				<a:entry id="2"><a:title>Second</a:title><a:link href="/2.html" rel="alternate"/><a:link href="/2" rel="self"/></a:entry></a:feed>`)
+		}, true},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.EqualsXML(`<feed><title>News</title></feed>`) }, false},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.EqualsXML(`<feed>`) }, false},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.HasElement("/feed/entry/link") }, true},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.HasElement("//link[@rel='alternate']") }, true},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.HasElement("/feed/*/title") }, true},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.HasElement("/feed/link") }, false},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.HasElement("//entry[3]") }, false},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.HasElement("feed") }, false},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.HasElement("/feed[last()]") }, false},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.ElementCount("//title").Equals(3) }, true},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.ElementCount("//link[1]").Equals(2) }, true},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.ElementCount("/feed/entry[@id='2']/link").Equals(2) }, true},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.ElementCount("//link").Equals(2) }, false},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.Attribute("//entry[2]/link[@rel='self']", "href").Equals("/2") }, true},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.Attribute("/feed/entry", "id").Equals("1") }, true},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.Attribute("/feed/entry", "type") }, false},
+		XMLTestCase{func(e *expectations.XMLExpectation) { e.Attribute("/feed/item", "id") }, false},
+	}
+
+	for i, testCase := range testCases {
+		tMock.reset()
+		testCase.Fn(et.ExpectThatXML(feed))
+		if testCase.Succeeds == tMock.HasBeenCalled {
+			t.Errorf("Test case %v failed: should be %v", i, testCase.Succeeds)
+		}
+	}
+}
+
+func TestXMLEqualsShowsPathOfDifferences(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatXML(`<order id="7" state="new"><item>pen</item><item>ink</item><total/></order>`).
+		EqualsXML(`<order id="8"><item>pen</item><item>paper</item><note/><total/></order>`)
+
+	for _, expected := range []string{
+		"found 5 difference(s)",
+		`/order/@id: want "8", got "7"`,
+		`/order/@state: unexpected "new"`,
+		`/order/item[2]/text(): want "paper", got "ink"`,
+		`/order/note: want <note>, got <total>`,
+		`/order/total: missing, want <total>`,
+	} {
+		if !strings.Contains(loggerMock.logs, expected) {
+			t.Errorf("Expected '%v' to contain '%v'", loggerMock.logs, expected)
+		}
+	}
+}
+
+func TestXMLComparesNamespaces(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatXML(`<s:Envelope xmlns:s="urn:soap-12"/>`).EqualsXML(`<soap:Envelope xmlns:soap="urn:soap-11"/>`)
+	if !strings.Contains(loggerMock.logs, "/Envelope: want <{urn:soap-11}Envelope>, got <{urn:soap-12}Envelope>") {
+		t.Errorf("Expected '%v' to show the different namespaces", loggerMock.logs)
+	}
+}
+
+func TestXMLAttributeStopsOnMissingElement(t *testing.T) {
+	tMock := &TMock{}
+	loggerMock := LoggerMock{}
+	et := expectations.NewTWithLogger(tMock, &loggerMock)
+
+	et.ExpectThatXML(feed).Attribute("//entry[@id='3']", "id").Equals("3")
+	if !strings.Contains(loggerMock.logs, "Expect XML to contain element //entry[@id='3']") {
+		t.Errorf("Expected '%v' to report the missing element", loggerMock.logs)
+	}
+	if strings.Count(loggerMock.logs, "Expect") != 1 {
+		t.Errorf("Expected '%v' to stop after the missing element", loggerMock.logs)
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/laliluna/expectations
 
-go 1.11
+go 1.21